@@ -0,0 +1,250 @@
+// Copyright © 2015 Gerald Rosenberg.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the License.md file.
+//
+package algorithms
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Default iteration limit, in sweeps, for the iterative decompositions
+const DefaultMaxIter = 100
+
+// Generic eigen-decomposition of a real symmetric matrix by cyclic Jacobi
+// rotation. Returns the eigenvalues in descending order and the matrix of
+// corresponding unit eigenvectors, as columns. Iterates until the norm of
+// the off-diagonal elements is no larger than tol times the norm of a,
+// reporting ErrNoConvergence after maxIter sweeps. Only the upper triangle
+// of a is used.
+func EigenSym_M(a M, tol float64, maxIter int) (V, M, error) {
+	if err := checkSquare_M(a); err != nil {
+		return nil, nil, err
+	}
+	n := a.Rows_M()
+	d := a.New_M(n, n)
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			d.Set_M(i, j, a.Get_M(i, j))
+			d.Set_M(j, i, a.Get_M(i, j))
+		}
+	}
+	scale := normalize_M(d)
+	vecs := Identity_M(a, n)
+	f := frobenius_M(d)
+	limit := f.Mul_S(f.ToS(tol))
+
+	for sweep := 0; offDiag_M(d).Compare_S(limit) > 0; sweep++ {
+		if sweep == maxIter {
+			return nil, nil, fmt.Errorf("%w: after %d sweeps", ErrNoConvergence, maxIter)
+		}
+		for p := 0; p < n-1; p++ {
+			for q := p + 1; q < n; q++ {
+				apq := d.Get_M(p, q)
+				if isZero_S(apq) {
+					continue
+				}
+				c, s := jacobi_S(d.Get_M(p, p), d.Get_M(q, q), apq)
+				rotateCols_M(d, p, q, c, s)
+				rotateRows_M(d, p, q, c, s)
+				rotateCols_M(vecs, p, q, c, s)
+			}
+		}
+	}
+
+	vals := a.NewV_M(n)
+	for i := 0; i < n; i++ {
+		vals.Set_V(i, d.Get_M(i, i).Mul_S(scale))
+	}
+	vals, vecs = sortDesc_M(vals, vecs)
+	return vals, vecs, nil
+}
+
+// Singular value decomposition, such that A = U diag(S) V^T, for an m by n
+// matrix A. For k = min(m, n), U is m by k, S holds the k singular values
+// in descending order, and V is n by k, each with orthonormal columns.
+type SVD struct {
+	U M
+	S V
+	V M
+}
+
+// Generic singular value decomposition of a real valued matrix by one-sided
+// Jacobi rotation. Iterates until every pair of columns is orthogonal to
+// within tol, reporting ErrNoConvergence after maxIter sweeps.
+func SVD_M(a M, tol float64, maxIter int) (*SVD, error) {
+	if a.Rows_M() < a.Cols_M() {
+		f, err := SVD_M(Transpose_M(a), tol, maxIter)
+		if err != nil {
+			return nil, err
+		}
+		return &SVD{U: f.V, S: f.S, V: f.U}, nil
+	}
+	m, n := a.Rows_M(), a.Cols_M()
+	u := a.Dup_M()
+	scale := normalize_M(u)
+	v := Identity_M(a, n)
+	zero := zero_M(a)
+
+	converged := false
+	for sweep := 0; sweep < maxIter && !converged; sweep++ {
+		converged = true
+		for p := 0; p < n-1; p++ {
+			for q := p + 1; q < n; q++ {
+				alpha, beta, gamma := zero, zero, zero
+				for i := 0; i < m; i++ {
+					up, uq := u.Get_M(i, p), u.Get_M(i, q)
+					alpha = alpha.Add_S(up.Mul_S(up))
+					beta = beta.Add_S(uq.Mul_S(uq))
+					gamma = gamma.Add_S(up.Mul_S(uq))
+				}
+				g := gamma.Abs_S()
+				if isZero_S(g) || g.Compare_S(alpha.Sqrt_S().Mul_S(beta.Sqrt_S()).Mul_S(g.ToS(tol))) <= 0 {
+					continue
+				}
+				converged = false
+				c, s := jacobi_S(alpha, beta, gamma)
+				rotateCols_M(u, p, q, c, s)
+				rotateCols_M(v, p, q, c, s)
+			}
+		}
+	}
+	if !converged {
+		return nil, fmt.Errorf("%w: after %d sweeps", ErrNoConvergence, maxIter)
+	}
+
+	// singular values are the column norms of the rotated matrix
+	sv := a.NewV_M(n)
+	for j := 0; j < n; j++ {
+		sum := zero
+		for i := 0; i < m; i++ {
+			x := u.Get_M(i, j)
+			sum = sum.Add_S(x.Mul_S(x))
+		}
+		norm := sum.Sqrt_S()
+		sv.Set_V(j, norm.Mul_S(scale))
+		if isZero_S(norm) {
+			continue
+		}
+		for i := 0; i < m; i++ {
+			u.Set_M(i, j, u.Get_M(i, j).Div_S(norm))
+		}
+	}
+	sv, u, v = sortDesc3_M(sv, u, v)
+	return &SVD{U: u, S: sv, V: v}, nil
+}
+
+// jacobi_S computes the cosine and sine of the Jacobi rotation that
+// annihilates the off-diagonal element apq of the symmetric 2 by 2 matrix
+// [app apq; apq aqq].
+func jacobi_S(app, aqq, apq S) (c, s S) {
+	one := apq.One_S()
+	theta := aqq.Sub_S(app).Div_S(apq.Add_S(apq))
+	t := one.Div_S(theta.Abs_S().Add_S(theta.Mul_S(theta).Add_S(one).Sqrt_S()))
+	if sign_S(theta) < 0 {
+		t = t.Zero_S().Sub_S(t)
+	}
+	c = one.Div_S(t.Mul_S(t).Add_S(one).Sqrt_S())
+	return c, t.Mul_S(c)
+}
+
+// rotateCols_M applies the rotation (c, s) to columns p and q of a.
+func rotateCols_M(a M, p, q int, c, s S) {
+	for k := 0; k < a.Rows_M(); k++ {
+		x, y := a.Get_M(k, p), a.Get_M(k, q)
+		a.Set_M(k, p, c.Mul_S(x).Sub_S(s.Mul_S(y)))
+		a.Set_M(k, q, s.Mul_S(x).Add_S(c.Mul_S(y)))
+	}
+}
+
+// rotateRows_M applies the transposed rotation (c, s) to rows p and q of a.
+func rotateRows_M(a M, p, q int, c, s S) {
+	for k := 0; k < a.Cols_M(); k++ {
+		x, y := a.Get_M(p, k), a.Get_M(q, k)
+		a.Set_M(p, k, c.Mul_S(x).Sub_S(s.Mul_S(y)))
+		a.Set_M(q, k, s.Mul_S(x).Add_S(c.Mul_S(y)))
+	}
+}
+
+// normalize_M divides the elements of a, in place, by their largest
+// magnitude, so that the sums of squares taken by the convergence tests
+// cannot overflow or underflow. Returns that magnitude, or one where a is
+// zero.
+func normalize_M(a M) S {
+	max := maxElem_M(a)
+	if isZero_S(max) {
+		return max.One_S()
+	}
+	for i := 0; i < a.Rows_M(); i++ {
+		for j := 0; j < a.Cols_M(); j++ {
+			a.Set_M(i, j, a.Get_M(i, j).Div_S(max))
+		}
+	}
+	return max
+}
+
+// frobenius_M returns the Frobenius norm of a.
+func frobenius_M(a M) S {
+	sum := zero_M(a)
+	for i := 0; i < a.Rows_M(); i++ {
+		for j := 0; j < a.Cols_M(); j++ {
+			x := a.Get_M(i, j).Abs_S()
+			sum = sum.Add_S(x.Mul_S(x))
+		}
+	}
+	return sum.Sqrt_S()
+}
+
+// offDiag_M returns the norm of the off-diagonal elements of a.
+func offDiag_M(a M) S {
+	sum := zero_M(a)
+	for i := 0; i < a.Rows_M(); i++ {
+		for j := 0; j < a.Cols_M(); j++ {
+			if i != j {
+				x := a.Get_M(i, j).Abs_S()
+				sum = sum.Add_S(x.Mul_S(x))
+			}
+		}
+	}
+	return sum.Sqrt_S()
+}
+
+// sortDesc_M orders the values in descending order, permuting the
+// columns of the matrix to match.
+func sortDesc_M(vals V, cols M) (V, M) {
+	vals, cols, _ = sortDesc3_M(vals, cols, nil)
+	return vals, cols
+}
+
+// sortDesc3_M orders the values in descending order, permuting the
+// columns of each non-nil matrix to match.
+func sortDesc3_M(vals V, a, b M) (V, M, M) {
+	n := vals.Len_V()
+	idx := make([]int, n)
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.SliceStable(idx, func(i, j int) bool {
+		return vals.Get_V(idx[i]).Compare_S(vals.Get_V(idx[j])) > 0
+	})
+	sv := vals.New_V()
+	for i, k := range idx {
+		sv.Set_V(i, vals.Get_V(k))
+	}
+	return sv, permuteCols_M(a, idx), permuteCols_M(b, idx)
+}
+
+// permuteCols_M returns a copy of a with column i taken from column idx[i].
+func permuteCols_M(a M, idx []int) M {
+	if a == nil {
+		return nil
+	}
+	res := a.New_M(a.Rows_M(), a.Cols_M())
+	for i, k := range idx {
+		for r := 0; r < a.Rows_M(); r++ {
+			res.Set_M(r, i, a.Get_M(r, k))
+		}
+	}
+	return res
+}
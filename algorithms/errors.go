@@ -0,0 +1,122 @@
+// Copyright © 2015 Gerald Rosenberg.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the License.md file.
+//
+package algorithms
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// Errors reported by the error-returning algorithms. Returned errors
+// wrap one of these values and may be tested using errors.Is.
+var (
+	ErrDimensionMismatch = errors.New("algorithms: dimension mismatch")
+	ErrDivideByZero      = errors.New("algorithms: divide by zero")
+	ErrOverflow          = errors.New("algorithms: overflow")
+	ErrSingular          = errors.New("algorithms: singular matrix")
+	ErrNotPosDef         = errors.New("algorithms: matrix not positive definite")
+	ErrNoConvergence     = errors.New("algorithms: no convergence")
+	ErrTypeMismatch      = errors.New("algorithms: type mismatch")
+	ErrUnknownOp         = errors.New("algorithms: unknown operation")
+	ErrUnsupportedOp     = errors.New("algorithms: unsupported operation")
+)
+
+// Optional vector externals for type-specific operations that detect errors,
+// such as integer overflow, that cannot be reported through the V API.
+// Err_V returns and clears the first error detected since the last call.
+type E interface {
+	Err_V() error
+}
+
+// Dimension handling modes for the error-returning algorithm variants
+type Mode int
+
+const (
+	// Lenient computes on the common prefix of mismatched vectors,
+	// matching the behavior of the non-error algorithm variants.
+	Lenient Mode = iota
+	// Strict rejects mismatched vectors with ErrDimensionMismatch.
+	Strict
+)
+
+// checkTypes_V verifies that each of the given vectors has the same
+// concrete type as the receiver vector.
+func checkTypes_V(res V, src ...V) error {
+	if res == nil {
+		return fmt.Errorf("%w: nil vector", ErrTypeMismatch)
+	}
+	rt := reflect.TypeOf(res)
+	for _, v := range src {
+		if v == nil {
+			return fmt.Errorf("%w: nil vector", ErrTypeMismatch)
+		}
+		if vt := reflect.TypeOf(v); vt != rt {
+			return fmt.Errorf("%w: %v and %v", ErrTypeMismatch, rt, vt)
+		}
+	}
+	return nil
+}
+
+// checkScalar_V verifies that the given scalar has the same concrete type as
+// the elements of the receiver vector.
+func checkScalar_V(res V, t S) error {
+	if t == nil {
+		return fmt.Errorf("%w: nil scalar", ErrTypeMismatch)
+	}
+	if res.Len_V() == 0 {
+		return nil
+	}
+	et, st := reflect.TypeOf(res.Get_V(0)), reflect.TypeOf(t)
+	if et != st {
+		return fmt.Errorf("%w: %v and %v", ErrTypeMismatch, et, st)
+	}
+	return nil
+}
+
+// checkDims_V verifies that, in strict mode, each of the given vectors has
+// the same dimension as the receiver vector.
+func checkDims_V(mode Mode, res V, src ...V) error {
+	if mode != Strict {
+		return nil
+	}
+	for _, v := range src {
+		if res.Len_V() != v.Len_V() {
+			return fmt.Errorf("%w: %d and %d", ErrDimensionMismatch, res.Len_V(), v.Len_V())
+		}
+	}
+	return nil
+}
+
+// clearErr_V discards any error pending on the receiver vector.
+func clearErr_V(res V) {
+	if e, ok := res.(E); ok {
+		e.Err_V()
+	}
+}
+
+// err_V returns any error detected by the receiver vector's externals.
+func err_V(res V) error {
+	if e, ok := res.(E); ok {
+		return e.Err_V()
+	}
+	return nil
+}
+
+// isZero_S reports whether the given scalar is zero valued.
+func isZero_S(t S) bool {
+	return t.Abs_S().Compare_S(t.Zero_S()) == 0
+}
+
+// isInf_S reports whether the given scalar is infinite: infinities are the
+// only non-zero values equal to their own double.
+func isInf_S(t S) bool {
+	return !isZero_S(t) && t.Add_S(t).Compare_S(t) == 0
+}
+
+// sign_S returns -1, 0 or +1 as the given scalar is negative, zero or positive.
+func sign_S(t S) int {
+	return t.Compare_S(t.Zero_S())
+}
@@ -0,0 +1,146 @@
+// Copyright © 2015 Gerald Rosenberg.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the License.md file.
+//
+package algorithms
+
+import "fmt"
+
+// QR decomposition, such that A = QR, for an m by n matrix A where m >= n.
+// Q is an m by m orthogonal matrix and R is an m by n upper triangular matrix.
+type QR struct {
+	Q M
+	R M
+}
+
+// Generic QR decomposition of a real valued matrix by Householder
+// reflections. Requires at least as many rows as columns.
+func QR_M(a M) (*QR, error) {
+	m, n := a.Rows_M(), a.Cols_M()
+	if m < n {
+		return nil, fmt.Errorf("%w: %dx%d has fewer rows than columns", ErrDimensionMismatch, m, n)
+	}
+	r := a.Dup_M()
+	q := Identity_M(a, m)
+	zero := zero_M(a)
+	two := zero.ToS(2)
+
+	for k := 0; k < n && k < m-1; k++ {
+		// Householder vector v, reflecting column k onto -sign(x0) ||x|| e0
+		v := a.NewV_M(m - k)
+		norm := zero
+		for i := k; i < m; i++ {
+			x := r.Get_M(i, k)
+			v.Set_V(i-k, x)
+			norm = norm.Add_S(x.Mul_S(x))
+		}
+		norm = norm.Sqrt_S()
+		if isZero_S(norm) {
+			continue
+		}
+		if sign_S(v.Get_V(0)) < 0 {
+			v.Set_V(0, v.Get_V(0).Sub_S(norm))
+		} else {
+			v.Set_V(0, v.Get_V(0).Add_S(norm))
+		}
+		vv := Dot_V(v, v)
+
+		// R = HR, for the columns k and beyond
+		for j := k; j < n; j++ {
+			s := zero
+			for i := k; i < m; i++ {
+				s = s.Add_S(v.Get_V(i - k).Mul_S(r.Get_M(i, j)))
+			}
+			s = two.Mul_S(s).Div_S(vv)
+			for i := k; i < m; i++ {
+				r.Set_M(i, j, r.Get_M(i, j).Sub_S(s.Mul_S(v.Get_V(i-k))))
+			}
+		}
+		// Q = QH
+		for row := 0; row < m; row++ {
+			s := zero
+			for i := k; i < m; i++ {
+				s = s.Add_S(q.Get_M(row, i).Mul_S(v.Get_V(i - k)))
+			}
+			s = two.Mul_S(s).Div_S(vv)
+			for i := k; i < m; i++ {
+				q.Set_M(row, i, q.Get_M(row, i).Sub_S(s.Mul_S(v.Get_V(i-k))))
+			}
+		}
+		// clear the annihilated elements of column k
+		for i := k + 1; i < m; i++ {
+			r.Set_M(i, k, zero)
+		}
+	}
+	return &QR{Q: q, R: r}, nil
+}
+
+// Generic Cholesky decomposition of a symmetric positive definite matrix,
+// returning the lower triangular L such that A = LL^T. Only the lower
+// triangle of a is used. Reports ErrNotPosDef where a is not positive
+// definite.
+func Cholesky_M(a M) (M, error) {
+	if err := checkSquare_M(a); err != nil {
+		return nil, err
+	}
+	n := a.Rows_M()
+	l := a.New_M(n, n)
+	for j := 0; j < n; j++ {
+		d := a.Get_M(j, j)
+		for k := 0; k < j; k++ {
+			d = d.Sub_S(l.Get_M(j, k).Mul_S(l.Get_M(j, k)))
+		}
+		if sign_S(d) <= 0 {
+			return nil, fmt.Errorf("%w: pivot %d", ErrNotPosDef, j)
+		}
+		d = d.Sqrt_S()
+		l.Set_M(j, j, d)
+		for i := j + 1; i < n; i++ {
+			s := a.Get_M(i, j)
+			for k := 0; k < j; k++ {
+				s = s.Sub_S(l.Get_M(i, k).Mul_S(l.Get_M(j, k)))
+			}
+			l.Set_M(i, j, s.Div_S(d))
+		}
+	}
+	return l, nil
+}
+
+// Generic least squares solution x minimizing ||Ax - b||, for an m by n
+// matrix A where m >= n, computed by QR decomposition. Also returns the
+// residual norm ||Ax - b||. Reports ErrSingular where A is rank deficient:
+// where a diagonal element of R is no larger in magnitude than tol times
+// the largest magnitude element of R.
+func LeastSquares_M(a M, b V, tol float64) (V, S, error) {
+	m, n := a.Rows_M(), a.Cols_M()
+	if b.Len_V() != m {
+		return nil, nil, fmt.Errorf("%w: %dx%d by %d", ErrDimensionMismatch, m, n, b.Len_V())
+	}
+	f, err := QR_M(a)
+	if err != nil {
+		return nil, nil, err
+	}
+	qtb, _ := MatVec_M(Transpose_M(f.Q), b)
+
+	max := maxElem_M(f.R)
+	limit := max.Mul_S(max.ToS(tol))
+	x := a.NewV_M(n)
+	for i := n - 1; i >= 0; i-- {
+		d := f.R.Get_M(i, i)
+		if d.Abs_S().Compare_S(limit) <= 0 {
+			return nil, nil, fmt.Errorf("%w: rank deficient at column %d", ErrSingular, i)
+		}
+		sum := qtb.Get_V(i)
+		for j := i + 1; j < n; j++ {
+			sum = sum.Sub_S(f.R.Get_M(i, j).Mul_S(x.Get_V(j)))
+		}
+		x.Set_V(i, sum.Div_S(d))
+	}
+
+	resid := b.Zero_V()
+	for i := n; i < m; i++ {
+		r := qtb.Get_V(i)
+		resid = resid.Add_S(r.Mul_S(r))
+	}
+	return x, resid.Sqrt_S(), nil
+}
@@ -0,0 +1,103 @@
+// Copyright © 2015 Gerald Rosenberg.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the License.md file.
+//
+package algorithms
+
+import "fmt"
+
+// Matrix externals - the external generic support API. The generic matrix
+// algorithms read their operands and return new matrices; callers sharing
+// a matrix between goroutines must synchronize its use.
+type M interface {
+	New_M(rows, cols int) M // new zero valued matrix of the same element type
+	Dup_M() M
+	Get_M(row, col int) S
+	Set_M(row, col int, val S)
+	Rows_M() int
+	Cols_M() int
+	NewV_M(dim int) V // new zero valued vector of the same element type
+}
+
+// Generic matrix product of a and b
+func MatMul_M(a, b M) (M, error) {
+	if a.Cols_M() != b.Rows_M() {
+		return nil, fmt.Errorf("%w: %dx%d by %dx%d", ErrDimensionMismatch,
+			a.Rows_M(), a.Cols_M(), b.Rows_M(), b.Cols_M())
+	}
+	res := a.New_M(a.Rows_M(), b.Cols_M())
+	zero := zero_M(a)
+	for i := 0; i < a.Rows_M(); i++ {
+		for j := 0; j < b.Cols_M(); j++ {
+			sum := zero
+			for k := 0; k < a.Cols_M(); k++ {
+				sum = sum.Add_S(a.Get_M(i, k).Mul_S(b.Get_M(k, j)))
+			}
+			res.Set_M(i, j, sum)
+		}
+	}
+	return res, nil
+}
+
+// Generic matrix transpose
+func Transpose_M(a M) M {
+	res := a.New_M(a.Cols_M(), a.Rows_M())
+	for i := 0; i < a.Rows_M(); i++ {
+		for j := 0; j < a.Cols_M(); j++ {
+			res.Set_M(j, i, a.Get_M(i, j))
+		}
+	}
+	return res
+}
+
+// Generic matrix-vector product of a and the column vector v
+func MatVec_M(a M, v V) (V, error) {
+	if a.Cols_M() != v.Len_V() {
+		return nil, fmt.Errorf("%w: %dx%d by %d", ErrDimensionMismatch,
+			a.Rows_M(), a.Cols_M(), v.Len_V())
+	}
+	res := a.NewV_M(a.Rows_M())
+	for i := 0; i < a.Rows_M(); i++ {
+		sum := v.Zero_V()
+		for k := 0; k < a.Cols_M(); k++ {
+			sum = sum.Add_S(a.Get_M(i, k).Mul_S(v.Get_V(k)))
+		}
+		res.Set_V(i, sum)
+	}
+	return res, nil
+}
+
+// Generic n by n identity matrix of the same element type as the given matrix
+func Identity_M(a M, n int) M {
+	res := a.New_M(n, n)
+	one := zero_M(a).One_S()
+	for i := 0; i < n; i++ {
+		res.Set_M(i, i, one)
+	}
+	return res
+}
+
+// Generic matrix trace
+func Trace_M(a M) (S, error) {
+	if err := checkSquare_M(a); err != nil {
+		return nil, err
+	}
+	res := zero_M(a)
+	for i := 0; i < a.Rows_M(); i++ {
+		res = res.Add_S(a.Get_M(i, i))
+	}
+	return res, nil
+}
+
+// zero_M returns a zero valued scalar of the matrix element type.
+func zero_M(a M) S {
+	return a.NewV_M(0).Zero_V()
+}
+
+// checkSquare_M verifies that the given matrix is square.
+func checkSquare_M(a M) error {
+	if a.Rows_M() != a.Cols_M() {
+		return fmt.Errorf("%w: %dx%d is not square", ErrDimensionMismatch, a.Rows_M(), a.Cols_M())
+	}
+	return nil
+}
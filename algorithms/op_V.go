@@ -0,0 +1,189 @@
+// Copyright © 2015 Gerald Rosenberg.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the License.md file.
+//
+package algorithms
+
+import (
+	"fmt"
+	"math"
+	"sync"
+)
+
+// Vector operation handle, as returned by RegisterOp
+type Op int
+
+// Vector operations enum - the pre-registered operations
+const (
+	AddOp Op = iota
+	SubOp
+	MulOp
+	DivOp
+	PowOp
+	MinOp
+	MaxOp
+)
+
+// Element operation: combines the element at pos of the receiver vector
+// with the element at pos of src, storing the result in the receiver.
+// Returns an error wrapping ErrUnsupportedOp where the operation is not
+// supported by the vector type.
+type ElementFn func(res V, pos int, src V) error
+
+// Scalar operation: combines the element at pos of the receiver vector
+// with the scalar t, storing the result in the receiver. Returns an error
+// wrapping ErrUnsupportedOp where the operation is not supported by the
+// vector type.
+type ScalarFn func(res V, pos int, t S) error
+
+type opEntry struct {
+	name    string
+	elem    ElementFn
+	scalar  ScalarFn
+	divisor bool // the operand is a divisor, and so must be non-zero
+}
+
+// ops is the operation registry, indexed by Op
+var ops = struct {
+	sync.RWMutex
+	entries []opEntry
+}{entries: []opEntry{
+	AddOp: {"add",
+		func(res V, pos int, src V) error { res.Add_V(pos, src); return nil },
+		func(res V, pos int, t S) error { res.AddSc_V(pos, t); return nil }, false},
+	SubOp: {"sub",
+		func(res V, pos int, src V) error { res.Sub_V(pos, src); return nil },
+		func(res V, pos int, t S) error { res.SubSc_V(pos, t); return nil }, false},
+	MulOp: {"mul",
+		func(res V, pos int, src V) error { res.Mul_V(pos, src); return nil },
+		func(res V, pos int, t S) error { res.MulSc_V(pos, t); return nil }, false},
+	DivOp: {"div",
+		func(res V, pos int, src V) error { res.Div_V(pos, src); return nil },
+		func(res V, pos int, t S) error { res.DivSc_V(pos, t); return nil }, true},
+	PowOp: floatOp("pow", math.Pow),
+	MinOp: elementOp("min", min_S),
+	MaxOp: elementOp("max", max_S),
+}}
+
+// Register a vector operation under the given name, returning its handle.
+// Either function may be nil, where the operation has no vector or no
+// scalar form. Panics if the name is already registered.
+func RegisterOp(name string, elementFn ElementFn, scalarFn ScalarFn) Op {
+	return registerOp(opEntry{name, elementFn, scalarFn, false})
+}
+
+// Register a vector operation whose operand is a divisor, as for DivOp,
+// returning its handle. The error-returning variants report a zero divisor
+// as ErrDivideByZero before modifying the receiver vector. Panics if the
+// name is already registered.
+func RegisterDivisorOp(name string, elementFn ElementFn, scalarFn ScalarFn) Op {
+	return registerOp(opEntry{name, elementFn, scalarFn, true})
+}
+
+// Register a vector operation computed element-wise by the given scalar
+// function, in both its vector and scalar forms, returning its handle.
+// Panics if the name is already registered.
+func RegisterElementOp(name string, fn func(a, b S) S) Op {
+	return registerOp(elementOp(name, fn))
+}
+
+// Register a vector operation computed element-wise by the given float64
+// function, in both its vector and scalar forms, returning its handle. The
+// operands are converted by ToFloat and the result by ToS, so that wider
+// element types, such as big.Float and big.Rat, are computed at float64
+// precision. Complex valued operands with a non-zero imaginary part are
+// reported as ErrUnsupportedOp, leaving the elements before the first such
+// operand modified. Panics if the name is already registered.
+func RegisterFloatOp(name string, fn func(x, y float64) float64) Op {
+	return registerOp(floatOp(name, fn))
+}
+
+// registerOp adds the given entry to the registry, returning its handle.
+func registerOp(e opEntry) Op {
+	ops.Lock()
+	defer ops.Unlock()
+	for _, r := range ops.entries {
+		if r.name == e.name {
+			panic(fmt.Sprintf("algorithms: operation %q already registered", e.name))
+		}
+	}
+	ops.entries = append(ops.entries, e)
+	return Op(len(ops.entries) - 1)
+}
+
+// Name of a registered operation
+func (op Op) String() string {
+	e, err := lookupOp(op)
+	if err != nil {
+		return fmt.Sprintf("Op(%d)", int(op))
+	}
+	return e.name
+}
+
+// lookupOp returns the registry entry of the given operation.
+func lookupOp(op Op) (opEntry, error) {
+	ops.RLock()
+	defer ops.RUnlock()
+	if op < 0 || int(op) >= len(ops.entries) {
+		return opEntry{}, fmt.Errorf("%w: %d", ErrUnknownOp, int(op))
+	}
+	return ops.entries[op], nil
+}
+
+// elementOp builds the registry entry of an operation computed element-wise
+// by the given scalar function.
+func elementOp(name string, fn func(a, b S) S) opEntry {
+	return opEntry{name,
+		func(res V, pos int, src V) error {
+			res.Set_V(pos, fn(res.Get_V(pos), src.Get_V(pos)))
+			return nil
+		},
+		func(res V, pos int, t S) error {
+			res.Set_V(pos, fn(res.Get_V(pos), t))
+			return nil
+		},
+		false,
+	}
+}
+
+// floatOp builds the registry entry of an operation computed element-wise
+// by the given float64 function.
+func floatOp(name string, fn func(x, y float64) float64) opEntry {
+	apply := func(res V, pos int, t S) error {
+		x := res.Get_V(pos)
+		if isComplex_S(x) || isComplex_S(t) {
+			return fmt.Errorf("%w: %s of complex %v and %v", ErrUnsupportedOp, name, x, t)
+		}
+		res.Set_V(pos, x.ToS(fn(x.ToFloat(), t.ToFloat())))
+		return nil
+	}
+	return opEntry{name,
+		func(res V, pos int, src V) error {
+			return apply(res, pos, src.Get_V(pos))
+		},
+		apply,
+		false,
+	}
+}
+
+// isComplex_S reports whether the given scalar has a non-zero imaginary part.
+func isComplex_S(t S) bool {
+	c, ok := t.(C)
+	return ok && !isZero_S(c.Imag_S())
+}
+
+// min_S returns the lesser of a and b.
+func min_S(a, b S) S {
+	if b.Compare_S(a) < 0 {
+		return b
+	}
+	return a
+}
+
+// max_S returns the greater of a and b.
+func max_S(a, b S) S {
+	if b.Compare_S(a) > 0 {
+		return b
+	}
+	return a
+}
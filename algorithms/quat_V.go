@@ -0,0 +1,147 @@
+// Copyright © 2015 Gerald Rosenberg.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the License.md file.
+//
+package algorithms
+
+import (
+	"fmt"
+	"math"
+)
+
+// Quaternion element positions: a quaternion is a 4 element vector
+// (x, y, z, w), where w is the scalar part.
+const (
+	qx = iota
+	qy
+	qz
+	qw
+)
+
+// Generic quaternion (Hamilton) product ab. Composes the rotations, such
+// that rotating by ab rotates first by b and then by a.
+func QuatMul_V(a, b V) V {
+	g := func(v V, i int) S { return v.Get_V(i) }
+	ax, ay, az, aw := g(a, qx), g(a, qy), g(a, qz), g(a, qw)
+	bx, by, bz, bw := g(b, qx), g(b, qy), g(b, qz), g(b, qw)
+
+	res := a.New_V()
+	res.Set_V(qw, aw.Mul_S(bw).Sub_S(ax.Mul_S(bx)).Sub_S(ay.Mul_S(by)).Sub_S(az.Mul_S(bz)))
+	res.Set_V(qx, aw.Mul_S(bx).Add_S(ax.Mul_S(bw)).Add_S(ay.Mul_S(bz)).Sub_S(az.Mul_S(by)))
+	res.Set_V(qy, aw.Mul_S(by).Sub_S(ax.Mul_S(bz)).Add_S(ay.Mul_S(bw)).Add_S(az.Mul_S(bx)))
+	res.Set_V(qz, aw.Mul_S(bz).Add_S(ax.Mul_S(by)).Sub_S(ay.Mul_S(bx)).Add_S(az.Mul_S(bw)))
+	return res
+}
+
+// Generic quaternion conjugate. The given quaternion is not modified.
+func QuatConj_V(a V) V {
+	res := a.Dup_V()
+	res.Neg_V(qx)
+	res.Neg_V(qy)
+	res.Neg_V(qz)
+	return res
+}
+
+// Generic quaternion inverse. The given quaternion is not modified.
+// A zero quaternion has no inverse and is returned unchanged.
+func QuatInverse_V(a V) V {
+	res := QuatConj_V(a)
+	n := Dot_V(a, a)
+	if isZero_S(n) {
+		return res
+	}
+	return ModifyScalar_V(res, DivOp, n)
+}
+
+// Generic rotation of the 3 element vector v by the unit quaternion q.
+// The given vector is not modified.
+func QuatRotate_V(q, v V) V {
+	u := v.New_V()
+	for i := qx; i <= qz; i++ {
+		u.Set_V(i, q.Get_V(i))
+	}
+	w := q.Get_V(qw)
+
+	// v' = v + wt + u x t, where t = 2(u x v)
+	t := Cross3_V(u, v)
+	ModifyScalar_V(t, MulOp, w.ToS(2))
+	res := v.Dup_V()
+	wt := t.Dup_V()
+	ModifyScalar_V(wt, MulOp, w)
+	return Modify_V(res, AddOp, wt, Cross3_V(u, t))
+}
+
+// Generic unit quaternion for the rotation by angle radians about the given
+// 3 element axis, stored in the 4 element res. The trigonometry is computed
+// through ToFloat, and so is limited to float64 precision.
+func QuatFromAxisAngle_V(res V, axis V, angle S) V {
+	n := Norm_V(axis, L2)
+	half := angle.ToFloat() / 2
+	sin := angle.ToS(math.Sin(half))
+	for i := qx; i <= qz; i++ {
+		e := axis.Get_V(i)
+		if !isZero_S(n) {
+			e = e.Div_S(n)
+		}
+		res.Set_V(i, e.Mul_S(sin))
+	}
+	res.Set_V(qw, angle.ToS(math.Cos(half)))
+	return res
+}
+
+// Generic unit quaternion for the rotation by the given Euler angles, in
+// radians, stored in the 4 element res. The rotation is applied as roll
+// about X, then pitch about Y, then yaw about Z. The trigonometry is
+// computed through ToFloat, and so is limited to float64 precision.
+func QuatFromEuler_V(res V, roll, pitch, yaw S) V {
+	cr, sr := math.Cos(roll.ToFloat()/2), math.Sin(roll.ToFloat()/2)
+	cp, sp := math.Cos(pitch.ToFloat()/2), math.Sin(pitch.ToFloat()/2)
+	cy, sy := math.Cos(yaw.ToFloat()/2), math.Sin(yaw.ToFloat()/2)
+
+	res.Set_V(qw, roll.ToS(cr*cp*cy+sr*sp*sy))
+	res.Set_V(qx, roll.ToS(sr*cp*cy-cr*sp*sy))
+	res.Set_V(qy, roll.ToS(cr*sp*cy+sr*cp*sy))
+	res.Set_V(qz, roll.ToS(cr*cp*sy-sr*sp*cy))
+	return res
+}
+
+// Generic unit quaternion for the rotation given by the upper left 3 by 3
+// rotation matrix of m, stored in the 4 element res.
+func QuatFromMatrix_V(res V, m M) (V, error) {
+	if m.Rows_M() < 3 || m.Cols_M() < 3 {
+		return nil, fmt.Errorf("%w: %dx%d is smaller than 3x3", ErrDimensionMismatch, m.Rows_M(), m.Cols_M())
+	}
+	e := func(i, j int) S { return m.Get_M(i, j) }
+	one := e(0, 0).One_S()
+	four := one.ToS(4)
+	m00, m11, m22 := e(0, 0), e(1, 1), e(2, 2)
+
+	// Shepperd's method: select the largest of w, x, y and z to divide by
+	switch {
+	case sign_S(m00.Add_S(m11).Add_S(m22)) > 0:
+		s := one.Add_S(m00).Add_S(m11).Add_S(m22).Sqrt_S().Mul_S(one.ToS(2))
+		res.Set_V(qw, s.Div_S(four))
+		res.Set_V(qx, e(2, 1).Sub_S(e(1, 2)).Div_S(s))
+		res.Set_V(qy, e(0, 2).Sub_S(e(2, 0)).Div_S(s))
+		res.Set_V(qz, e(1, 0).Sub_S(e(0, 1)).Div_S(s))
+	case m00.Compare_S(m11) > 0 && m00.Compare_S(m22) > 0:
+		s := one.Add_S(m00).Sub_S(m11).Sub_S(m22).Sqrt_S().Mul_S(one.ToS(2))
+		res.Set_V(qw, e(2, 1).Sub_S(e(1, 2)).Div_S(s))
+		res.Set_V(qx, s.Div_S(four))
+		res.Set_V(qy, e(0, 1).Add_S(e(1, 0)).Div_S(s))
+		res.Set_V(qz, e(0, 2).Add_S(e(2, 0)).Div_S(s))
+	case m11.Compare_S(m22) > 0:
+		s := one.Add_S(m11).Sub_S(m00).Sub_S(m22).Sqrt_S().Mul_S(one.ToS(2))
+		res.Set_V(qw, e(0, 2).Sub_S(e(2, 0)).Div_S(s))
+		res.Set_V(qx, e(0, 1).Add_S(e(1, 0)).Div_S(s))
+		res.Set_V(qy, s.Div_S(four))
+		res.Set_V(qz, e(1, 2).Add_S(e(2, 1)).Div_S(s))
+	default:
+		s := one.Add_S(m22).Sub_S(m00).Sub_S(m11).Sqrt_S().Mul_S(one.ToS(2))
+		res.Set_V(qw, e(1, 0).Sub_S(e(0, 1)).Div_S(s))
+		res.Set_V(qx, e(0, 2).Add_S(e(2, 0)).Div_S(s))
+		res.Set_V(qy, e(1, 2).Add_S(e(2, 1)).Div_S(s))
+		res.Set_V(qz, s.Div_S(four))
+	}
+	return Normalize_V(res), nil
+}
@@ -5,9 +5,9 @@
 package algorithms
 
 import (
+	"reflect"
+	"runtime"
 	"sync"
-
-	"github.com/grosenberg/generic"
 )
 
 /*
@@ -18,7 +18,9 @@ import (
  */
 type R interface {
 	sync.Locker
+	New_R() R // new empty register of the same type and configuration
 	Add_R(Q)
+	Merge_R(R) // adds the contributions of the given register, which is unchanged
 	Update_R()
 	Value_R() Q
 	Count_R() Q
@@ -39,11 +41,11 @@ type Q interface{}
  * an interface.
  */
 func Accumulate_R(rcv R, r Q) Q {
-	vals := generic.ValueOf(r)
+	vals := reflect.ValueOf(r)
 	for i := 0; i < vals.Len(); i++ {
 		val := vals.Index(i).Interface()
 		rcv.Add_R(val)
-	} 
+	}
 	return rcv.Count_R()
 }
 
@@ -53,7 +55,79 @@ func Compute_R(rcv R) Q {
 }
 
 func Reset_R(rcv R) Q {
-	ret := rcv.Count_R()
+	ret := rcv.Value_R()
 	rcv.Clear_R()
 	return ret
 }
+
+/*
+ * Merges the contributions of each of the other registers into the
+ * receiver, in order, and returns the resulting count of contributions.
+ * Unlike the other generic register functions, MergeAll_R does its own
+ * locking: each other register is snapshot under its own lock before the
+ * receiver is locked, so that concurrent merges of registers into each
+ * other cannot deadlock. The receiver must not already be locked.
+ */
+func MergeAll_R(rcv R, others ...R) Q {
+	snaps := make([]R, len(others))
+	for i, o := range others {
+		snaps[i] = snapshot_R(rcv, o)
+	}
+	rcv.Lock()
+	defer rcv.Unlock()
+	for _, o := range snaps {
+		rcv.Merge_R(o)
+	}
+	return rcv.Count_R()
+}
+
+// snapshot_R returns a new register, configured as the receiver, holding
+// the contributions of the given register, which is locked while copied.
+func snapshot_R(rcv, o R) R {
+	o.Lock()
+	defer o.Unlock()
+	s := rcv.New_R()
+	s.Merge_R(o)
+	return s
+}
+
+/*
+ * Accumulates the given slice of values using the given number of workers,
+ * or GOMAXPROCS workers where not positive, and returns the resulting count
+ * of contributions. The values are sharded into contiguous ranges, each
+ * accumulated into a new register by its own goroutine; the shard registers
+ * are then merged into the receiver in shard order, so that the result is
+ * deterministic for a given number of workers.
+ */
+func AccumulateParallel_R(rcv R, r Q, workers int) Q {
+	vals := reflect.ValueOf(r)
+	n := vals.Len()
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	if workers > n {
+		workers = n
+	}
+	if workers <= 1 {
+		return Accumulate_R(rcv, r)
+	}
+
+	shards := make([]R, workers)
+	var wg sync.WaitGroup
+	for w := range shards {
+		shards[w] = rcv.New_R()
+		lo, hi := w*n/workers, (w+1)*n/workers
+		wg.Add(1)
+		go func(reg R, lo, hi int) {
+			defer wg.Done()
+			for i := lo; i < hi; i++ {
+				reg.Add_R(vals.Index(i).Interface())
+			}
+		}(shards[w], lo, hi)
+	}
+	wg.Wait()
+	for _, reg := range shards {
+		rcv.Merge_R(reg)
+	}
+	return rcv.Count_R()
+}
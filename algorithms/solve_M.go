@@ -0,0 +1,172 @@
+// Copyright © 2015 Gerald Rosenberg.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the License.md file.
+//
+package algorithms
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Default relative tolerances used to detect singular matrices: a pivot
+// no larger in magnitude than the tolerance times the largest magnitude
+// element of the matrix is treated as zero. DefaultTol suits float64 and
+// wider elements; DefaultTol32 suits float32 elements.
+const (
+	DefaultTol   = 1e-12
+	DefaultTol32 = 1e-6
+)
+
+// LU decomposition with partial pivoting, such that PA = LU. The unit lower
+// triangular L, without its unit diagonal, and the upper triangular U are
+// stored together in LU.
+type LU struct {
+	LU   M     // combined L and U factors
+	Perm []int // row i of PA is row Perm[i] of A
+	Sign int   // parity of the permutation, +1 or -1
+}
+
+// Generic LU decomposition with partial pivoting. Reports ErrSingular where
+// a pivot is no larger in magnitude than tol times the largest magnitude
+// element of a.
+func LU_M(a M, tol float64) (*LU, error) {
+	if err := checkSquare_M(a); err != nil {
+		return nil, err
+	}
+	n := a.Rows_M()
+	lu := a.Dup_M()
+	perm := make([]int, n)
+	for i := range perm {
+		perm[i] = i
+	}
+	sign := 1
+	max := maxElem_M(a)
+	limit := max.Mul_S(max.ToS(tol))
+
+	for k := 0; k < n; k++ {
+		// select the largest magnitude pivot in column k
+		p, pmax := k, lu.Get_M(k, k).Abs_S()
+		for i := k + 1; i < n; i++ {
+			if v := lu.Get_M(i, k).Abs_S(); v.Compare_S(pmax) > 0 {
+				p, pmax = i, v
+			}
+		}
+		if pmax.Compare_S(limit) <= 0 {
+			return nil, fmt.Errorf("%w: pivot %d", ErrSingular, k)
+		}
+		if p != k {
+			swapRows_M(lu, p, k)
+			perm[p], perm[k] = perm[k], perm[p]
+			sign = -sign
+		}
+
+		pivot := lu.Get_M(k, k)
+		for i := k + 1; i < n; i++ {
+			f := lu.Get_M(i, k).Div_S(pivot)
+			lu.Set_M(i, k, f)
+			for j := k + 1; j < n; j++ {
+				lu.Set_M(i, j, lu.Get_M(i, j).Sub_S(f.Mul_S(lu.Get_M(k, j))))
+			}
+		}
+	}
+	return &LU{LU: lu, Perm: perm, Sign: sign}, nil
+}
+
+// Generic solution of LUx = Pb for x, given the LU decomposition of A
+func SolveLU_M(f *LU, b V) (V, error) {
+	n := f.LU.Rows_M()
+	if b.Len_V() != n {
+		return nil, fmt.Errorf("%w: %dx%d by %d", ErrDimensionMismatch, n, n, b.Len_V())
+	}
+	x := f.LU.NewV_M(n)
+	// forward substitution, Ly = Pb
+	for i := 0; i < n; i++ {
+		sum := b.Get_V(f.Perm[i])
+		for j := 0; j < i; j++ {
+			sum = sum.Sub_S(f.LU.Get_M(i, j).Mul_S(x.Get_V(j)))
+		}
+		x.Set_V(i, sum)
+	}
+	// back substitution, Ux = y
+	for i := n - 1; i >= 0; i-- {
+		sum := x.Get_V(i)
+		for j := i + 1; j < n; j++ {
+			sum = sum.Sub_S(f.LU.Get_M(i, j).Mul_S(x.Get_V(j)))
+		}
+		x.Set_V(i, sum.Div_S(f.LU.Get_M(i, i)))
+	}
+	return x, nil
+}
+
+// Generic solution of Ax = b for x. Reports ErrSingular for singular or
+// near-singular A, as by LU_M with the given tolerance.
+func Solve_M(a M, b V, tol float64) (V, error) {
+	f, err := LU_M(a, tol)
+	if err != nil {
+		return nil, err
+	}
+	return SolveLU_M(f, b)
+}
+
+// Generic matrix determinant. Singular matrices have a zero determinant.
+func Det_M(a M) (S, error) {
+	f, err := LU_M(a, 0)
+	if errors.Is(err, ErrSingular) {
+		return zero_M(a), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	zero := zero_M(a)
+	det := zero.One_S()
+	if f.Sign < 0 {
+		det = zero.Sub_S(det)
+	}
+	for i := 0; i < a.Rows_M(); i++ {
+		det = det.Mul_S(f.LU.Get_M(i, i))
+	}
+	return det, nil
+}
+
+// Generic matrix inverse. Reports ErrSingular for singular or near-singular
+// matrices, as by LU_M with the given tolerance.
+func Inverse_M(a M, tol float64) (M, error) {
+	f, err := LU_M(a, tol)
+	if err != nil {
+		return nil, err
+	}
+	n := a.Rows_M()
+	res := a.New_M(n, n)
+	for j := 0; j < n; j++ {
+		e := a.NewV_M(n)
+		e.Set_V(j, e.Zero_V().One_S())
+		x, _ := SolveLU_M(f, e)
+		for i := 0; i < n; i++ {
+			res.Set_M(i, j, x.Get_V(i))
+		}
+	}
+	return res, nil
+}
+
+// maxElem_M returns the largest element magnitude of the given matrix.
+func maxElem_M(a M) S {
+	max := zero_M(a)
+	for i := 0; i < a.Rows_M(); i++ {
+		for j := 0; j < a.Cols_M(); j++ {
+			if v := a.Get_M(i, j).Abs_S(); v.Compare_S(max) > 0 {
+				max = v
+			}
+		}
+	}
+	return max
+}
+
+// swapRows_M exchanges two rows of the given matrix.
+func swapRows_M(a M, p, q int) {
+	for j := 0; j < a.Cols_M(); j++ {
+		t := a.Get_M(p, j)
+		a.Set_M(p, j, a.Get_M(q, j))
+		a.Set_M(q, j, t)
+	}
+}
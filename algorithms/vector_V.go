@@ -5,18 +5,12 @@
 package algorithms
 
 import (
+	"errors"
+	"fmt"
 	"math"
 	"sync"
 )
 
-// Vector operations enum
-const (
-	AddOp = iota
-	SubOp
-	MulOp
-	DivOp
-)
-
 // Vector externals - the external generic support API
 type V interface {
 	sync.Locker
@@ -28,60 +22,118 @@ type V interface {
 	Sub_V(int, V)
 	Mul_V(int, V)
 	Div_V(int, V)
+	AddSc_V(int, S)
+	SubSc_V(int, S)
 	MulSc_V(int, S)
 	DivSc_V(int, S)
 	Neg_V(int)
 	Len_V() int
 	LenMin_V(V) int
+	Zero_V() S
 }
 
 // Scalar externals
+//
+// ToFloat returns the scalar's real valued float64 approximation, used by
+// the generic algorithms for tolerance tests and for the operations computed
+// at float64 precision. For complex valued scalars, ToFloat returns the real
+// part and ToS returns a scalar with a zero imaginary part; Abs_S returns
+// the modulus as a real valued scalar, so that magnitudes, and thus norms
+// and zero tests, remain well defined. Floor_S, Ceil_S and Round_S round
+// each part of a complex valued scalar; Round_S rounds half away from zero.
+//
+// Compare_S returns -1, 0 or +1 as the receiver is less than, equal to or
+// greater than the given scalar. Complex valued scalars are ordered by real
+// and then imaginary part; floating point NaN orders before all other values.
 type S interface {
 	Add_S(S) S
 	Sub_S(S) S
 	Mul_S(S) S
 	Div_S(S) S
+	Abs_S() S
+	Sqrt_S() S
+	Floor_S() S
+	Ceil_S() S
+	Round_S() S
+	Compare_S(S) int
+
+	Zero_S() S
+	One_S() S
 
 	ToFloat() float64
 	ToS(float64) S
 }
 
-// Generic algorithm for Vector Add, Subtract, Multiply and Divide
-func Modify_V(res V, op int, src ...V) V {
+// Optional scalar externals for complex valued scalars. Imag_S returns the
+// imaginary part as a real valued scalar; ToFloat returns the real part.
+type C interface {
+	Imag_S() S
+}
+
+// Generic algorithm for Vector Add, Subtract, Multiply, Divide, Power,
+// Minimum, Maximum and any other registered operation. The PowOp operation
+// is computed as by RegisterFloatOp, and so is limited to float64 precision
+// and real values. Unknown operations are ignored; panics if the operation
+// is not supported by the vector type.
+func Modify_V(res V, op Op, src ...V) V {
+	if err := modify_V(res, op, src...); err != nil && !errors.Is(err, ErrUnknownOp) {
+		panic(err)
+	}
+	return res
+}
+
+// Generic algorithm for Vector Add, Subtract, Multiply, Divide, Power,
+// Minimum, Maximum and any other registered operation by Scalar. The PowOp
+// operation is computed as by RegisterFloatOp, and so is limited to float64
+// precision and real values. Unknown operations are ignored; panics if the
+// operation is not supported by the vector type.
+func ModifyScalar_V(res V, op Op, t S) V {
+	if err := modifyScalar_V(res, op, t); err != nil && !errors.Is(err, ErrUnknownOp) {
+		panic(err)
+	}
+	return res
+}
+
+// modify_V applies the registered vector form of op.
+func modify_V(res V, op Op, src ...V) error {
+	e, err := lookupOp(op)
+	if err != nil {
+		return err
+	}
+	if e.elem == nil {
+		return fmt.Errorf("%w: %v has no vector form", ErrUnsupportedOp, op)
+	}
 	res.Lock()
 	defer res.Unlock()
 	for _, v := range src {
 		i := res.LenMin_V(v)
 		for j := 0; j < i; j++ {
-			switch op {
-			case AddOp:
-				res.Add_V(j, v)
-			case SubOp:
-				res.Sub_V(j, v)
-			case MulOp:
-				res.Mul_V(j, v)
-			case DivOp:
-				res.Div_V(j, v)
+			if err := e.elem(res, j, v); err != nil {
+				return err
 			}
 		}
 	}
-	return res
+	return nil
 }
 
-// Generic algorithm for Vector Multiply and Divide by Scalar
-func ModifyScalar_V(res V, op int, t S) V {
+// modifyScalar_V applies the registered scalar form of op.
+func modifyScalar_V(res V, op Op, t S) error {
+	e, err := lookupOp(op)
+	if err != nil {
+		return err
+	}
+	if e.scalar == nil {
+		return fmt.Errorf("%w: %v has no scalar form", ErrUnsupportedOp, op)
+	}
 	res.Lock()
 	defer res.Unlock()
 	i := res.Len_V()
 	for j := 0; j < i; j++ {
-		switch op {
-		case MulOp:
-			res.MulSc_V(j, t)
-		case DivOp:
-			res.DivSc_V(j, t)
+		if err := e.scalar(res, j, t); err != nil {
+			return err
 		}
 	}
-	return res
+	return nil
 }
 
 // Generic algorithm for Vector negation
@@ -95,18 +147,225 @@ func Negate_V(res V) V {
 	return res
 }
 
+// Generic algorithm for the element-wise minimum of the receiver
+// and a set of vectors
+func Min_V(res V, src ...V) V {
+	return Modify_V(res, MinOp, src...)
+}
+
+// Generic algorithm for the element-wise maximum of the receiver
+// and a set of vectors
+func Max_V(res V, src ...V) V {
+	return Modify_V(res, MaxOp, src...)
+}
+
+// Generic algorithm to clamp each vector element to the range [lo, hi]
+func Clamp_V(res V, lo, hi S) V {
+	ModifyScalar_V(res, MaxOp, lo)
+	return ModifyScalar_V(res, MinOp, hi)
+}
+
+// Generic algorithm to raise each vector element to the power p. The
+// power is computed through ToFloat, and so is limited to float64 precision.
+func Pow_V(res V, p S) V {
+	return ModifyScalar_V(res, PowOp, p)
+}
+
+// Generic algorithm for the absolute value of each vector element
+func Abs_V(res V) V {
+	return Map_V(res, S.Abs_S)
+}
+
+// Generic algorithm for the square root of each vector element
+func Sqrt_V(res V) V {
+	return Map_V(res, S.Sqrt_S)
+}
+
+// Generic algorithm to round each vector element down
+func Floor_V(res V) V {
+	return Map_V(res, S.Floor_S)
+}
+
+// Generic algorithm to round each vector element up
+func Ceil_V(res V) V {
+	return Map_V(res, S.Ceil_S)
+}
+
+// Generic algorithm to round each vector element to the nearest
+// integer, rounding half away from zero
+func Round_V(res V) V {
+	return Map_V(res, S.Round_S)
+}
+
+// Generic algorithm to replace each vector element e with fn(e)
+func Map_V(res V, fn func(S) S) V {
+	res.Lock()
+	defer res.Unlock()
+	i := res.Len_V()
+	for j := 0; j < i; j++ {
+		res.Set_V(j, fn(res.Get_V(j)))
+	}
+	return res
+}
+
+// Generic algorithm to replace each vector element e with fn(e, b[j]),
+// where j is the element position
+func ZipWith_V(res, b V, fn func(S, S) S) V {
+	res.Lock()
+	defer res.Unlock()
+	i := res.LenMin_V(b)
+	for j := 0; j < i; j++ {
+		res.Set_V(j, fn(res.Get_V(j), b.Get_V(j)))
+	}
+	return res
+}
+
 // Generic Dot product
 func Dot_V(a, b V) (res S) {
+	res = a.Zero_V()
 	dim := a.LenMin_V(b)
 	for pos := 0; pos < dim; pos++ {
 		i := a.Get_V(pos)
 		j := b.Get_V(pos)
-		res.Add_S(i.Mul_S(j))
+		res = res.Add_S(i.Mul_S(j))
 	}
 	return res
 }
 
-// Generic algorithm for linear interpolation
+// Generic sum of the vector elements
+func Sum_V(a V) (res S) {
+	res = a.Zero_V()
+	for pos := 0; pos < a.Len_V(); pos++ {
+		res = res.Add_S(a.Get_V(pos))
+	}
+	return res
+}
+
+// Generic product of the vector elements
+func Product_V(a V) (res S) {
+	res = a.Zero_V().One_S()
+	for pos := 0; pos < a.Len_V(); pos++ {
+		res = res.Mul_S(a.Get_V(pos))
+	}
+	return res
+}
+
+// Common vector norm orders
+const (
+	L1 = 1.0
+	L2 = 2.0
+)
+
+// L-infinity (maximum) vector norm order
+var LInf = math.Inf(1)
+
+// Generic vector p-norm. The L1, L2 and LInf norms are computed using the
+// scalar externals; norms of any other order p are computed through
+// ToFloat, and so are limited to float64 precision.
+func Norm_V(a V, p float64) (res S) {
+	res = a.Zero_V()
+	dim := a.Len_V()
+	switch p {
+	case L1:
+		for pos := 0; pos < dim; pos++ {
+			res = res.Add_S(a.Get_V(pos).Abs_S())
+		}
+	case L2:
+		for pos := 0; pos < dim; pos++ {
+			i := a.Get_V(pos).Abs_S()
+			res = res.Add_S(i.Mul_S(i))
+		}
+		res = res.Sqrt_S()
+	case LInf:
+		for pos := 0; pos < dim; pos++ {
+			i := a.Get_V(pos).Abs_S()
+			if i.Compare_S(res) > 0 {
+				res = i
+			}
+		}
+	default:
+		sum := 0.0
+		for pos := 0; pos < dim; pos++ {
+			sum += math.Pow(a.Get_V(pos).Abs_S().ToFloat(), p)
+		}
+		res = res.ToS(math.Pow(sum, 1/p))
+	}
+	return res
+}
+
+// Generic p-norm distance between two vectors
+func Distance_V(a, b V, p float64) S {
+	tmp := a.Dup_V()
+	Modify_V(tmp, SubOp, b)
+	return Norm_V(tmp, p)
+}
+
+// Generic cosine similarity of two vectors. Returns zero where
+// either vector is of zero length.
+func CosineSimilarity_V(a, b V) S {
+	na, nb := Norm_V(a, L2), Norm_V(b, L2)
+	if isZero_S(na) || isZero_S(nb) {
+		return a.Zero_V()
+	}
+	return Dot_V(a, b).Div_S(na.Mul_S(nb))
+}
+
+// Generic approximate equality of two scalars: true where |a - b| is at
+// most absTol, or at most relTol times the larger of |a| and |b|. The
+// tolerances are converted as by ToS; NaN is not equal to any value, and
+// an infinity is equal only to itself.
+func ApproxEqual_S(a, b S, absTol, relTol float64) bool {
+	if math.IsNaN(a.ToFloat()) || math.IsNaN(b.ToFloat()) {
+		return false
+	}
+	if a.Compare_S(b) == 0 {
+		return true
+	}
+	if isInf_S(a) || isInf_S(b) {
+		return false
+	}
+	d := a.Sub_S(b).Abs_S()
+	if d.Compare_S(a.ToS(absTol)) <= 0 {
+		return true
+	}
+	m := a.Abs_S()
+	if bm := b.Abs_S(); bm.Compare_S(m) > 0 {
+		m = bm
+	}
+	return d.Compare_S(m.Mul_S(a.ToS(relTol))) <= 0
+}
+
+// Generic approximate equality of two vectors: true where the vectors have
+// the same dimension and each pair of elements is equal as by ApproxEqual_S
+func ApproxEqual_V(a, b V, absTol, relTol float64) bool {
+	if a.Len_V() != b.Len_V() {
+		return false
+	}
+	for pos := 0; pos < a.Len_V(); pos++ {
+		if !ApproxEqual_S(a.Get_V(pos), b.Get_V(pos), absTol, relTol) {
+			return false
+		}
+	}
+	return true
+}
+
+// Generic exact equality of two vectors, for the exact element types such
+// as integers and rationals: true where the vectors have the same dimension
+// and each pair of elements compares equal as by Compare_S
+func Equal_V(a, b V) bool {
+	if a.Len_V() != b.Len_V() {
+		return false
+	}
+	for pos := 0; pos < a.Len_V(); pos++ {
+		if a.Get_V(pos).Compare_S(b.Get_V(pos)) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Generic algorithm for linear interpolation. The given
+// vectors are not modified.
 func Lerp_V(a, b V, t S) V {
 	tmp := b.Dup_V()
 	Modify_V(tmp, SubOp, a)
@@ -114,19 +373,217 @@ func Lerp_V(a, b V, t S) V {
 	return Modify_V(tmp, AddOp, a)
 }
 
-// Generic algorithm for spherical linear interpolation
+// Generic algorithm for normalized linear interpolation. The given
+// vectors are not modified.
+func NLerp_V(a, b V, t S) V {
+	return Normalize_V(Lerp_V(a, b, t))
+}
+
+// Generic algorithm for spherical linear interpolation. Interpolates
+// between unit length copies of the given vectors, which are not
+// modified, and returns a unit length vector. The interpolation is
+// undefined for opposing vectors.
 func SLerp_V(a, b V, t S) V {
-	cosAngle := Dot_V(a, b).ToFloat()
-	scale0 := 1.0 - t.ToFloat()
-	scale1 := t.ToFloat()
-	if cosAngle < 0.999 {
-		angle := math.Acos(cosAngle)
-		recipSinAngle := 1.0 / math.Sin(angle)
-		scale0 = (math.Sin(((1.0 - t.ToFloat()) * angle)) * recipSinAngle)
-		scale1 = (math.Sin((t.ToFloat() * angle)) * recipSinAngle)
-	}
-	ModifyScalar_V(a, MulOp, t.ToS(scale0))
-	ModifyScalar_V(b, MulOp, t.ToS(scale1))
-
-	return Modify_V(a, AddOp, b)
+	return slerp_V(a, b, t, false)
+}
+
+// Generic algorithm for spherical linear interpolation along the shortest
+// arc, as required for quaternions where q and -q represent the same
+// rotation. Otherwise as SLerp_V.
+func SLerpShortest_V(a, b V, t S) V {
+	return slerp_V(a, b, t, true)
+}
+
+func slerp_V(a, b V, t S, shortest bool) V {
+	a0 := Normalize_V(a.Dup_V())
+	b0 := Normalize_V(b.Dup_V())
+	cosAngle := Dot_V(a0, b0).ToFloat()
+	if shortest && cosAngle < 0 {
+		Negate_V(b0)
+		cosAngle = -cosAngle
+	}
+	if cosAngle >= 0.999 {
+		return NLerp_V(a0, b0, t)
+	}
+	angle := math.Acos(math.Max(cosAngle, -1))
+	recipSinAngle := 1.0 / math.Sin(angle)
+	scale0 := math.Sin((1.0-t.ToFloat())*angle) * recipSinAngle
+	scale1 := math.Sin(t.ToFloat()*angle) * recipSinAngle
+
+	ModifyScalar_V(a0, MulOp, t.ToS(scale0))
+	ModifyScalar_V(b0, MulOp, t.ToS(scale1))
+	return Modify_V(a0, AddOp, b0)
+}
+
+// Generic algorithm to scale a vector to unit length, modifying the
+// receiver vector. A zero length vector is left unchanged.
+func Normalize_V(res V) V {
+	n := Norm_V(res, L2)
+	if isZero_S(n) {
+		return res
+	}
+	return ModifyScalar_V(res, DivOp, n)
+}
+
+// Generic cross product of two 3 element vectors
+func Cross3_V(a, b V) V {
+	g := func(v V, i int) S { return v.Get_V(i) }
+	res := a.New_V()
+	res.Set_V(0, g(a, 1).Mul_S(g(b, 2)).Sub_S(g(a, 2).Mul_S(g(b, 1))))
+	res.Set_V(1, g(a, 2).Mul_S(g(b, 0)).Sub_S(g(a, 0).Mul_S(g(b, 2))))
+	res.Set_V(2, g(a, 0).Mul_S(g(b, 1)).Sub_S(g(a, 1).Mul_S(g(b, 0))))
+	return res
+}
+
+// Generic projection of a onto b. The projection onto a
+// zero length vector is the zero vector.
+func Project_V(a, b V) V {
+	res := b.Dup_V()
+	bb := Dot_V(b, b)
+	if isZero_S(bb) {
+		return ModifyScalar_V(res, MulOp, bb)
+	}
+	return ModifyScalar_V(res, MulOp, Dot_V(a, b).Div_S(bb))
+}
+
+// Generic rejection of a from b: the component of a
+// orthogonal to b
+func Reject_V(a, b V) V {
+	return Modify_V(a.Dup_V(), SubOp, Project_V(a, b))
+}
+
+// Generic reflection of the incident vector v about the plane
+// with the given unit normal
+func Reflect_V(v, normal V) V {
+	d := Dot_V(v, normal)
+	tmp := normal.Dup_V()
+	ModifyScalar_V(tmp, MulOp, d.Add_S(d))
+	return Modify_V(v.Dup_V(), SubOp, tmp)
+}
+
+// Generic refraction of the unit incident vector v through the surface
+// with the given unit normal, for the ratio of refractive indices eta.
+// Total internal reflection yields the zero vector.
+func Refract_V(v, normal V, eta S) V {
+	one := eta.One_S()
+	d := Dot_V(normal, v)
+	k := one.Sub_S(eta.Mul_S(eta).Mul_S(one.Sub_S(d.Mul_S(d))))
+	res := v.Dup_V()
+	if sign_S(k) < 0 {
+		return ModifyScalar_V(res, MulOp, eta.Zero_S())
+	}
+	ModifyScalar_V(res, MulOp, eta)
+	tmp := normal.Dup_V()
+	ModifyScalar_V(tmp, MulOp, eta.Mul_S(d).Add_S(k.Sqrt_S()))
+	return Modify_V(res, SubOp, tmp)
+}
+
+// Generic angle, in radians, between two vectors. The angle is computed
+// through ToFloat, and so is limited to float64 precision. The angle
+// involving a zero length vector is zero.
+func Angle_V(a, b V) S {
+	c := CosineSimilarity_V(a, b).ToFloat()
+	return a.Zero_V().ToS(math.Acos(math.Max(-1, math.Min(1, c))))
+}
+
+/////////////////////////////////////////////////////////////
+// Error-returning algorithm variants
+//
+// Each variant validates its arguments before modifying any vector, so a
+// returned validation error leaves the receiver vector unchanged. Errors
+// detected during computation by vectors implementing E, such as integer
+// overflow, are returned after the computation completes.
+
+// Generic algorithm for Vector modification, reporting mismatched types and
+// dimensions, unknown and unsupported operations and division by zero.
+func ModifyErr_V(mode Mode, res V, op Op, src ...V) (V, error) {
+	e, err := lookupOp(op)
+	if err != nil {
+		return res, err
+	}
+	if err := checkTypes_V(res, src...); err != nil {
+		return res, err
+	}
+	if err := checkDims_V(mode, res, src...); err != nil {
+		return res, err
+	}
+	if e.divisor {
+		for _, v := range src {
+			i := res.LenMin_V(v)
+			for j := 0; j < i; j++ {
+				if isZero_S(v.Get_V(j)) {
+					return res, fmt.Errorf("%w: element %d", ErrDivideByZero, j)
+				}
+			}
+		}
+	}
+	clearErr_V(res)
+	if err := modify_V(res, op, src...); err != nil {
+		return res, err
+	}
+	return res, err_V(res)
+}
+
+// Generic algorithm for Vector modification by Scalar, reporting mismatched
+// types, unknown and unsupported operations and division by zero.
+func ModifyScalarErr_V(res V, op Op, t S) (V, error) {
+	e, err := lookupOp(op)
+	if err != nil {
+		return res, err
+	}
+	if err := checkTypes_V(res); err != nil {
+		return res, err
+	}
+	if err := checkScalar_V(res, t); err != nil {
+		return res, err
+	}
+	if e.divisor && isZero_S(t) {
+		return res, ErrDivideByZero
+	}
+	clearErr_V(res)
+	if err := modifyScalar_V(res, op, t); err != nil {
+		return res, err
+	}
+	return res, err_V(res)
+}
+
+// Generic Dot product, reporting mismatched types and dimensions.
+func DotErr_V(mode Mode, a, b V) (S, error) {
+	if err := checkTypes_V(a, b); err != nil {
+		return nil, err
+	}
+	if err := checkDims_V(mode, a, b); err != nil {
+		return nil, err
+	}
+	return Dot_V(a, b), nil
+}
+
+// Generic algorithm for linear interpolation, reporting mismatched types
+// and dimensions.
+func LerpErr_V(mode Mode, a, b V, t S) (V, error) {
+	if err := checkTypes_V(a, b); err != nil {
+		return nil, err
+	}
+	if err := checkDims_V(mode, a, b); err != nil {
+		return nil, err
+	}
+	if err := checkScalar_V(a, t); err != nil {
+		return nil, err
+	}
+	return Lerp_V(a, b, t), nil
+}
+
+// Generic algorithm for spherical linear interpolation, reporting mismatched
+// types and dimensions.
+func SLerpErr_V(mode Mode, a, b V, t S) (V, error) {
+	if err := checkTypes_V(a, b); err != nil {
+		return nil, err
+	}
+	if err := checkDims_V(mode, a, b); err != nil {
+		return nil, err
+	}
+	if err := checkScalar_V(a, t); err != nil {
+		return nil, err
+	}
+	return SLerp_V(a, b, t), nil
 }
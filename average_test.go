@@ -5,9 +5,13 @@
 package maths
 
 import (
-	"github.com/grosenberg/generics/floats"
-	"github.com/grosenberg/generics/ints"
+	"math"
 	"testing"
+	"time"
+
+	. "github.com/grosenberg/maths/algorithms"
+	"github.com/grosenberg/maths/floats"
+	"github.com/grosenberg/maths/ints"
 )
 
 func TestAverageInts(t *testing.T) {
@@ -21,3 +25,334 @@ func TestAverageFloats(t *testing.T) {
 		t.Errorf("Wrong. f is %v", a)
 	}
 }
+
+func TestStatsFloats(t *testing.T) {
+	s := floats.Statistics(2, 4, 4, 4, 5, 5, 7, 9)
+	want := floats.Stats{Count: 8, Mean: 5, Variance: 4, SampleVariance: 32.0 / 7, StdDev: 2,
+		SampleStdDev: math.Sqrt(32.0 / 7), Min: 2, Max: 9, Skewness: 0.65625, Kurtosis: -0.21875}
+	if s.Count != want.Count || s.Min != want.Min || s.Max != want.Max {
+		t.Errorf("Wrong. stats are %+v", s)
+	}
+	got := []float64{s.Mean, s.Variance, s.SampleVariance, s.StdDev, s.SampleStdDev, s.Skewness, s.Kurtosis}
+	exp := []float64{want.Mean, want.Variance, want.SampleVariance, want.StdDev, want.SampleStdDev, want.Skewness, want.Kurtosis}
+	for i := range got {
+		if math.Abs(got[i]-exp[i]) > 1e-12 {
+			t.Errorf("Wrong. stats are %+v", s)
+			break
+		}
+	}
+
+	// large offsets defeat the naive sum of squares
+	reg := floats.NewStatsRegister()
+	reg.Accumulate(1e9+4, 1e9+7, 1e9+13, 1e9+16)
+	if s := reg.Compute(); s.Variance != 22.5 {
+		t.Errorf("Wrong. variance is %v", s.Variance)
+	}
+	if s := reg.Reset(); s.Count != 4 || reg.Compute() != (floats.Stats{}) {
+		t.Errorf("Wrong. reset stats are %+v", s)
+	}
+}
+
+func TestStatsInts(t *testing.T) {
+	s := ints.Statistics(2, 4, 4, 4, 5, 5, 7, 9)
+	if s.Count != 8 || s.Min != 2 || s.Max != 9 || math.Abs(s.Mean-5) > 1e-12 || math.Abs(s.Variance-4) > 1e-12 {
+		t.Errorf("Wrong. stats are %+v", s)
+	}
+	if s := ints.Statistics(3); s.Mean != 3 || s.SampleVariance != 0 || s.Skewness != 0 {
+		t.Errorf("Wrong. single value stats are %+v", s)
+	}
+}
+
+func TestMergeRegisters(t *testing.T) {
+	a, b := floats.NewRegister(), floats.NewRegister()
+	a.Accumulate(1, 2)
+	b.Accumulate(3, 4, 5)
+	if n := a.Merge(b); n != 5 || a.Compute() != 3 || b.Compute() != 4 {
+		t.Errorf("Wrong. merged mean is %v", a.Compute())
+	}
+	if n := a.Merge(a); n != 10 || a.Compute() != 3 {
+		t.Errorf("Wrong. self merge count is %v", n)
+	}
+
+	vals := make([]float64, 1001)
+	for i := range vals {
+		vals[i] = math.Sin(float64(i)) * 1e3
+	}
+	all := floats.Statistics(vals...)
+	x, y := floats.NewStatsRegister(), floats.NewStatsRegister()
+	x.Accumulate(vals[:300]...)
+	y.Accumulate(vals[300:]...)
+	x.Merge(y)
+	m := x.Compute()
+	if m.Count != all.Count || m.Min != all.Min || m.Max != all.Max {
+		t.Errorf("Wrong. merged stats are %+v", m)
+	}
+	got := []float64{m.Mean, m.Variance, m.Skewness, m.Kurtosis}
+	exp := []float64{all.Mean, all.Variance, all.Skewness, all.Kurtosis}
+	for i := range got {
+		if math.Abs(got[i]-exp[i]) > 1e-9*math.Max(1, math.Abs(exp[i])) {
+			t.Errorf("Wrong. merged stats are %+v, want %+v", m, all)
+			break
+		}
+	}
+
+	is := ints.NewStatsRegister()
+	is.Merge(ints.NewStatsRegister())
+	if s := is.Compute(); s.Count != 0 {
+		t.Errorf("Wrong. empty merge is %+v", s)
+	}
+
+	// concurrent merges of two registers into each other
+	p, q := ints.NewRegister(), ints.NewRegister()
+	p.Accumulate(1)
+	q.Accumulate(1)
+	done := make(chan bool)
+	for _, r := range [][2]*ints.Register{{p, q}, {q, p}} {
+		go func(a, b *ints.Register) {
+			for i := 0; i < 20; i++ {
+				a.Merge(b)
+			}
+			done <- true
+		}(r[0], r[1])
+	}
+	for i := 0; i < 2; i++ {
+		select {
+		case <-done:
+		case <-time.After(10 * time.Second):
+			t.Fatalf("Wrong. concurrent merges deadlocked")
+		}
+	}
+}
+
+func TestAccumulateParallel(t *testing.T) {
+	vals := make([]float64, 10007)
+	for i := range vals {
+		vals[i] = math.Cos(float64(i))
+	}
+	var first floats.Stats
+	for run := 0; run < 5; run++ {
+		reg := floats.NewStatsRegister()
+		if n := reg.AccumulateParallel(vals, 7); n != len(vals) {
+			t.Fatalf("Wrong. count is %v", n)
+		}
+		s := reg.Compute()
+		if run == 0 {
+			first = s
+		} else if s != first {
+			t.Errorf("Wrong. run %d gives %+v, want %+v", run, s, first)
+		}
+	}
+	if seq := floats.Statistics(vals...); math.Abs(seq.Variance-first.Variance) > 1e-12 {
+		t.Errorf("Wrong. variance is %v, want %v", first.Variance, seq.Variance)
+	}
+
+	ireg := ints.NewRegister()
+	if n := ireg.AccumulateParallel([]int{1, 2, 3, 4, 5, 6}, 0); n != 6 || ireg.Compute() != 3 {
+		t.Errorf("Wrong. parallel average is %v", ireg.Compute())
+	}
+	if n := ints.NewRegister().AccumulateParallel(nil, 4); n != 0 {
+		t.Errorf("Wrong. empty count is %v", n)
+	}
+}
+
+func TestWindowRegister(t *testing.T) {
+	reg := floats.NewWindowRegister(3)
+	if n := reg.Accumulate(1, 2, 3, 4, 5); n != 3 || reg.Compute() != 4 {
+		t.Errorf("Wrong. window mean is %v of %d", reg.Compute(), n)
+	}
+
+	// the generic algorithms apply unchanged
+	var r R = floats.NewWindowRegister(2)
+	Accumulate_R(r, []float64{10, 20, 30})
+	if v := Compute_R(r); v != 25.0 {
+		t.Errorf("Wrong. window mean is %v", v)
+	}
+	if v := Reset_R(r); v != 25.0 || Compute_R(r) != 0.0 {
+		t.Errorf("Wrong. reset value is %v", v)
+	}
+
+	other := floats.NewWindowRegister(2)
+	other.Accumulate(6)
+	if n := reg.Merge(other); n != 3 || reg.Compute() != 5 {
+		t.Errorf("Wrong. merged window mean is %v", reg.Compute())
+	}
+}
+
+func TestTimeWindowRegister(t *testing.T) {
+	t0 := time.Date(2015, 1, 1, 0, 0, 0, 0, time.UTC)
+	at := func(sec int, v float64) floats.Sample {
+		return floats.Sample{T: t0.Add(time.Duration(sec) * time.Second), V: v}
+	}
+	reg := floats.NewTimeWindowRegister(10 * time.Second)
+	reg.Accumulate(at(0, 100), at(5, 2), at(8, 4))
+	if v := reg.Compute(); math.Abs(v-106.0/3) > 1e-12 {
+		t.Errorf("Wrong. mean is %v", v)
+	}
+	// the sample at 0 is no longer within 10 seconds of the newest;
+	// late samples are placed in time order
+	if n := reg.Accumulate(at(10, 6), at(3, 8)); n != 4 || reg.Compute() != 5 {
+		t.Errorf("Wrong. mean is %v of %d", reg.Compute(), n)
+	}
+	other := floats.NewTimeWindowRegister(10 * time.Second)
+	other.Accumulate(at(14, 10))
+	if n := reg.Merge(other); n != 4 || reg.Compute() != 5.5 {
+		t.Errorf("Wrong. merged mean is %v of %d", reg.Compute(), n)
+	}
+}
+
+func TestEWMARegister(t *testing.T) {
+	reg := floats.NewEWMARegister(0.5)
+	reg.Accumulate(1, 2, 3)
+	if v := reg.Compute(); math.Abs(v-17.0/7) > 1e-15 {
+		t.Errorf("Wrong. ewma is %v", v)
+	}
+	if a := floats.NewEWMARegisterHalfLife(2).Alpha(); math.Abs((1-a)*(1-a)-0.5) > 1e-15 {
+		t.Errorf("Wrong. alpha is %v", a)
+	}
+
+	vals := []float64{3, 1, 4, 1, 5, 9, 2, 6}
+	all := floats.NewEWMARegister(0.2)
+	all.Accumulate(vals...)
+	a, b := floats.NewEWMARegister(0.2), floats.NewEWMARegister(0.2)
+	a.Accumulate(vals[:3]...)
+	b.Accumulate(vals[3:]...)
+	if n := a.Merge(b); n != 8 || math.Abs(a.Compute()-all.Compute()) > 1e-14 {
+		t.Errorf("Wrong. merged ewma is %v, want %v", a.Compute(), all.Compute())
+	}
+	p := floats.NewEWMARegister(0.2)
+	if AccumulateParallel_R(p, vals, 3); math.Abs(p.Compute()-all.Compute()) > 1e-14 {
+		t.Errorf("Wrong. parallel ewma is %v", p.Compute())
+	}
+
+	for _, f := range []func(){
+		func() { floats.NewEWMARegister(0) },
+		func() { floats.NewEWMARegister(1.5) },
+		func() { floats.NewEWMARegister(math.NaN()) },
+		func() { floats.NewEWMARegisterHalfLife(-1) },
+		func() { floats.NewEWMARegisterHalfLife(0) },
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Wrong. invalid ewma did not panic")
+				}
+			}()
+			f()
+		}()
+	}
+}
+
+func TestQuantileRegister(t *testing.T) {
+	// a permutation of 0 .. n-1
+	const n = 100000
+	vals := make([]float64, n)
+	for i := range vals {
+		vals[i] = float64(i * 7919 % n)
+	}
+	reg := floats.NewQuantileRegister(0.5)
+	reg.Accumulate(vals...)
+	if v := reg.Compute(); math.Abs(v-n/2) > 0.005*n {
+		t.Errorf("Wrong. median is %v", v)
+	}
+	qs := []float64{0, 0.001, 0.1, 0.9, 0.99, 0.999, 1}
+	for i, v := range reg.Quantiles(qs...) {
+		// the error bound narrows toward the tails
+		tol := n * math.Max(0.0005, 0.02*qs[i]*(1-qs[i]))
+		if want := qs[i] * (n - 1); math.Abs(v-want) > tol {
+			t.Errorf("Wrong. quantile %v is %v, want %v", qs[i], v, want)
+		}
+	}
+
+	a, b := floats.NewQuantileRegister(0.99), floats.NewQuantileRegister(0.99)
+	a.Accumulate(vals[:n/3]...)
+	b.Accumulate(vals[n/3:]...)
+	if c := a.Merge(b); c != n || math.Abs(a.Compute()-0.99*n) > 0.001*n {
+		t.Errorf("Wrong. merged p99 is %v of %d", a.Compute(), c)
+	}
+	p, q := floats.NewQuantileRegister(0.9), floats.NewQuantileRegister(0.9)
+	p.AccumulateParallel(vals, 4)
+	q.AccumulateParallel(vals, 4)
+	if p.Compute() != q.Compute() || math.Abs(p.Compute()-0.9*n) > 0.005*n {
+		t.Errorf("Wrong. parallel p90 is %v and %v", p.Compute(), q.Compute())
+	}
+
+	if v := floats.NewQuantileRegister(0.5).Compute(); v != 0 {
+		t.Errorf("Wrong. empty median is %v", v)
+	}
+	one := floats.NewQuantileRegister(0.5)
+	one.Accumulate(42)
+	if v := one.Quantiles(0, 0.5, 1); v[0] != 42 || v[1] != 42 || v[2] != 42 {
+		t.Errorf("Wrong. single value quantiles are %v", v)
+	}
+}
+
+func TestHistogramRegister(t *testing.T) {
+	reg := floats.NewFixedWidthHistogramRegister(0, 10, 4)
+	vals := []float64{-5, 0, 5, 12, 15, 25, 39.5, 40, 50}
+	if n := Accumulate_R(reg, vals).(int); n != 9 {
+		t.Errorf("Wrong. count is %d", n)
+	}
+	want := []floats.Bucket{
+		{Lo: 0, Hi: 10, Count: 2}, {Lo: 10, Hi: 20, Count: 2},
+		{Lo: 20, Hi: 30, Count: 1}, {Lo: 30, Hi: 40, Count: 1},
+	}
+	got := reg.Buckets()
+	if len(got) != len(want) {
+		t.Fatalf("Wrong. buckets are %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("Wrong. bucket %d is %v, want %v", i, got[i], want[i])
+		}
+	}
+	if reg.Underflow() != 1 || reg.Overflow() != 2 {
+		t.Errorf("Wrong. underflow %d, overflow %d", reg.Underflow(), reg.Overflow())
+	}
+	if v := reg.Compute(); math.Abs(v-181.5/9) > 1e-12 {
+		t.Errorf("Wrong. mean is %v", v)
+	}
+	for _, c := range []struct{ x, want float64 }{
+		{-10, 0}, {-5, 0}, {0, 1.0 / 9}, {5, 2.0 / 9}, {10, 3.0 / 9},
+		{25, 5.5 / 9}, {40, 7.0 / 9}, {45, 8.0 / 9}, {50, 1},
+	} {
+		if v := reg.CDF(c.x); math.Abs(v-c.want) > 1e-12 {
+			t.Errorf("Wrong. CDF(%v) is %v, want %v", c.x, v, c.want)
+		}
+	}
+
+	exp := floats.NewExponentialHistogramRegister(1, 2, 3)
+	exp.Accumulate(0.5, 1, 3, 7, 8)
+	if b := exp.Buckets(); len(b) != 3 || b[2] != (floats.Bucket{Lo: 4, Hi: 8, Count: 1}) || b[1].Count != 1 {
+		t.Errorf("Wrong. exponential buckets are %v", b)
+	}
+	user := floats.NewHistogramRegister(5, 1, 2, 2)
+	if b := user.Buckets(); len(b) != 2 || b[0].Lo != 1 || b[1].Hi != 5 {
+		t.Errorf("Wrong. user buckets are %v", b)
+	}
+
+	a, b := floats.NewFixedWidthHistogramRegister(0, 10, 4), floats.NewFixedWidthHistogramRegister(0, 10, 4)
+	a.Accumulate(vals[:4]...)
+	b.Accumulate(vals[4:]...)
+	if n := a.Merge(b); n != 9 || a.Compute() != reg.Compute() || a.CDF(25) != reg.CDF(25) {
+		t.Errorf("Wrong. merged histogram is %v", a.Buckets())
+	}
+	p := floats.NewFixedWidthHistogramRegister(0, 10, 4)
+	if p.AccumulateParallel(vals, 3); p.Overflow() != 2 || p.CDF(25) != reg.CDF(25) {
+		t.Errorf("Wrong. parallel histogram is %v", p.Buckets())
+	}
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Errorf("Wrong. merge of different bounds did not panic")
+			}
+		}()
+		a.Merge(exp)
+	}()
+	if exp.Accumulate(2); a.Accumulate(5) != 10 || exp.Buckets()[1].Count != 2 {
+		t.Errorf("Wrong. registers unusable after a failed merge")
+	}
+
+	if reg.Reset(); reg.Underflow() != 0 || reg.Buckets()[0].Count != 0 || reg.CDF(5) != 0 {
+		t.Errorf("Wrong. reset histogram is %v", reg.Buckets())
+	}
+}
@@ -0,0 +1,171 @@
+// Copyright © 2015 Gerald Rosenberg.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the License.md file.
+//
+package big
+
+import (
+	"math/big"
+
+	. "github.com/grosenberg/maths/algorithms"
+)
+
+/////////////////////////////////////////////////////////////
+// Matrix type-specific API
+
+// Create a new Matrix of the given dimensions, optionally
+// initialized from the given values in row-major order
+func NewMatrix(rows, cols int, vals ...big.Float) *Matrix {
+	m := &Matrix{rows: rows, cols: cols}
+	m.Elem = make([]Scalar, rows*cols)
+	for i := 0; i < len(vals) && i < len(m.Elem); i++ {
+		m.Elem[i] = Scalar(*dup(&vals[i]))
+	}
+	return m
+}
+
+// Create a new n by n identity Matrix
+func NewIdentity(n int) *Matrix {
+	return Identity_M(&Matrix{}, n).(*Matrix)
+}
+
+// Create a copy of an existing Matrix
+func (a *Matrix) CopyMatrix() *Matrix {
+	b := NewMatrix(a.rows, a.cols)
+	for i := range a.Elem {
+		b.Elem[i] = Scalar(*dup((*big.Float)(&a.Elem[i])))
+	}
+	return b
+}
+
+// Number of rows
+func (a *Matrix) Rows() int {
+	return a.rows
+}
+
+// Number of columns
+func (a *Matrix) Cols() int {
+	return a.cols
+}
+
+// Element at the given row and column
+func (a *Matrix) At(row, col int) big.Float {
+	return big.Float(a.Get_M(row, col).(Scalar))
+}
+
+// Set the element at the given row and column
+func (a *Matrix) SetAt(row, col int, val big.Float) *Matrix {
+	a.Set_M(row, col, Scalar(val))
+	return a
+}
+
+// Matrix product of the receiver and b
+func (a *Matrix) MatMul(b *Matrix) (*Matrix, error) {
+	m, err := MatMul_M(a, b)
+	if err != nil {
+		return nil, err
+	}
+	return m.(*Matrix), nil
+}
+
+// Transpose
+func (a *Matrix) Transpose() *Matrix {
+	return Transpose_M(a).(*Matrix)
+}
+
+// Product of the receiver and the column vector v
+func (a *Matrix) MulVec(v *Vector) (*Vector, error) {
+	r, err := MatVec_M(a, v)
+	if err != nil {
+		return nil, err
+	}
+	return r.(*Vector), nil
+}
+
+// Trace
+func (a *Matrix) Trace() (big.Float, error) {
+	t, err := Trace_M(a)
+	if err != nil {
+		return big.Float{}, err
+	}
+	return big.Float(t.(Scalar)), nil
+}
+
+// Solve Ax = b for x, where A is the receiver, using a tolerance scaled to
+// the precision of its elements
+func (a *Matrix) Solve(b *Vector) (*Vector, error) {
+	x, err := Solve_M(a, b, a.tol())
+	if err != nil {
+		return nil, err
+	}
+	return x.(*Vector), nil
+}
+
+// Determinant
+func (a *Matrix) Det() (big.Float, error) {
+	d, err := Det_M(a)
+	if err != nil {
+		return big.Float{}, err
+	}
+	return big.Float(d.(Scalar)), nil
+}
+
+// Inverse, using a tolerance scaled to the precision of the elements
+func (a *Matrix) Inverse() (*Matrix, error) {
+	m, err := Inverse_M(a, a.tol())
+	if err != nil {
+		return nil, err
+	}
+	return m.(*Matrix), nil
+}
+
+// QR decomposition, returning the orthogonal Q and upper triangular R
+func (a *Matrix) QR() (q, r *Matrix, err error) {
+	f, err := QR_M(a)
+	if err != nil {
+		return nil, nil, err
+	}
+	return f.Q.(*Matrix), f.R.(*Matrix), nil
+}
+
+// Cholesky decomposition, returning the lower triangular L
+func (a *Matrix) Cholesky() (*Matrix, error) {
+	l, err := Cholesky_M(a)
+	if err != nil {
+		return nil, err
+	}
+	return l.(*Matrix), nil
+}
+
+// Least squares solution x minimizing ||Ax - b||, where A is the
+// receiver, and the residual norm ||Ax - b||, using a tolerance scaled to
+// the precision of its elements
+func (a *Matrix) LeastSquares(b *Vector) (*Vector, big.Float, error) {
+	x, r, err := LeastSquares_M(a, b, a.tol())
+	if err != nil {
+		return nil, big.Float{}, err
+	}
+	return x.(*Vector), big.Float(r.(Scalar)), nil
+}
+
+// Eigen-decomposition of a symmetric matrix, returning the eigenvalues in
+// descending order and the corresponding eigenvectors as columns. See
+// EigenSym_M for the tolerance and iteration limit.
+func (a *Matrix) EigenSym(tol float64, maxIter int) (*Vector, *Matrix, error) {
+	vals, vecs, err := EigenSym_M(a, tol, maxIter)
+	if err != nil {
+		return nil, nil, err
+	}
+	return vals.(*Vector), vecs.(*Matrix), nil
+}
+
+// Singular value decomposition, returning U, the singular values in
+// descending order and V, such that A = U diag(S) V^T. See SVD_M for the
+// tolerance and iteration limit.
+func (a *Matrix) SVD(tol float64, maxIter int) (u *Matrix, s *Vector, v *Matrix, err error) {
+	f, err := SVD_M(a, tol, maxIter)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return f.U.(*Matrix), f.S.(*Vector), f.V.(*Matrix), nil
+}
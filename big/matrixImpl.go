@@ -0,0 +1,82 @@
+// Copyright © 2015 Gerald Rosenberg.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the License.md file.
+//
+package big
+
+import (
+	"math"
+	"math/big"
+
+	. "github.com/grosenberg/maths/algorithms"
+)
+
+// Type specfic composite 'value' compatible with the intended generic type algorithm
+// implemenetation.
+type Matrix struct {
+	Elem       []Scalar // elements in row-major order
+	rows, cols int
+}
+
+/////////////////////////////////////////////////////////////
+// Compile-time implementation prover
+var _ M = &Matrix{}
+
+/////////////////////////////////////////////////////////////
+// Type-specific support for generic implementation
+
+// ... for the M API
+
+// New
+func (a *Matrix) New_M(rows, cols int) M {
+	return NewMatrix(rows, cols)
+}
+
+// Dup (copy)
+func (a *Matrix) Dup_M() M {
+	return a.CopyMatrix()
+}
+
+// Get - returns a copy of the element
+func (a *Matrix) Get_M(row, col int) S {
+	return Scalar(*dup(a.elem(row, col)))
+}
+
+// Set - stores a copy of the given element
+func (a *Matrix) Set_M(row, col int, b S) {
+	x := b.(Scalar)
+	a.Elem[row*a.cols+col] = Scalar(*dup(x.float()))
+}
+
+// Rows
+func (a *Matrix) Rows_M() int {
+	return a.rows
+}
+
+// Columns
+func (a *Matrix) Cols_M() int {
+	return a.cols
+}
+
+// New vector
+func (a *Matrix) NewV_M(dim int) V {
+	return NewVector(dim)
+}
+
+// elem returns the element at the given row and column as a big.Float
+func (a *Matrix) elem(row, col int) *big.Float {
+	return (*big.Float)(&a.Elem[row*a.cols+col])
+}
+
+// tol returns the relative tolerance used to detect singular matrices at the
+// precision of the elements: 2^-(prec-8), leaving eight bits of headroom for
+// rounding error. Elements of zero precision count as float64 precision.
+func (a *Matrix) tol() float64 {
+	prec := uint(53)
+	for i := range a.Elem {
+		if p := a.Elem[i].float().Prec(); p > prec {
+			prec = p
+		}
+	}
+	return math.Ldexp(1, 8-int(prec))
+}
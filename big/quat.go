@@ -0,0 +1,97 @@
+// Copyright © 2015 Gerald Rosenberg.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the License.md file.
+//
+package big
+
+import (
+	"math/big"
+
+	. "github.com/grosenberg/maths/algorithms"
+)
+
+// Quaternion (x, y, z, w), where w is the scalar part, held as a 4 element
+// Vector. Operations return a new Quat and never modify the receiver.
+type Quat Vector
+
+/////////////////////////////////////////////////////////////
+// Quat type-specific API
+
+// Create a new Quat from its elements
+func NewQuat(x, y, z, w big.Float) *Quat {
+	q := NewVector(4)
+	for i, e := range []big.Float{x, y, z, w} {
+		q.Set_V(i, Scalar(e))
+	}
+	return (*Quat)(q)
+}
+
+// Identity Quat
+func QuatIdent() *Quat {
+	q := NewVector(4)
+	q.Set_V(W, q.Zero_V().One_S())
+	return (*Quat)(q)
+}
+
+// Create a Quat for the rotation by angle radians about the given
+// 3 element axis. The trigonometry is computed at float64 precision.
+func QuatFromAxisAngle(axis *Vector, angle big.Float) *Quat {
+	return (*Quat)(QuatFromAxisAngle_V(NewVector(4), axis, Scalar(angle)).(*Vector))
+}
+
+// Create a Quat for the rotation by the given Euler angles, in radians,
+// applied as roll about X, then pitch about Y, then yaw about Z. The
+// trigonometry is computed at float64 precision.
+func QuatFromEuler(roll, pitch, yaw big.Float) *Quat {
+	return (*Quat)(QuatFromEuler_V(NewVector(4), Scalar(roll), Scalar(pitch), Scalar(yaw)).(*Vector))
+}
+
+// Create a Quat from the upper left 3 by 3 rotation matrix of m
+func QuatFromMatrix(m *Matrix) (*Quat, error) {
+	q, err := QuatFromMatrix_V(NewVector(4), m)
+	if err != nil {
+		return nil, err
+	}
+	return (*Quat)(q.(*Vector)), nil
+}
+
+// The quaternion elements as a Vector
+func (a *Quat) Vector() *Vector {
+	return (*Vector)(a)
+}
+
+// Quaternion product, composing the rotations: rotating by a.Mul(b)
+// rotates first by b and then by a
+func (a *Quat) Mul(b *Quat) *Quat {
+	return (*Quat)(QuatMul_V(a.Vector(), b.Vector()).(*Vector))
+}
+
+// Conjugate
+func (a *Quat) Conjugate() *Quat {
+	return (*Quat)(QuatConj_V(a.Vector()).(*Vector))
+}
+
+// Inverse
+func (a *Quat) Inverse() *Quat {
+	return (*Quat)(QuatInverse_V(a.Vector()).(*Vector))
+}
+
+// Unit length copy; a zero quaternion is returned unchanged
+func (a *Quat) Normalize() *Quat {
+	b := a.Vector().CopyVector()
+	if n := b.Norm(L2); n.Sign() != 0 {
+		b.DivScalar(n)
+	}
+	return (*Quat)(b)
+}
+
+// Rotate the 3 element vector v, where the receiver is a unit quaternion
+func (a *Quat) Rotate(v *Vector) *Vector {
+	return QuatRotate_V(a.Vector(), v).(*Vector)
+}
+
+// Spherical linear interpolation along the shortest arc. The
+// interpolation angle is computed at float64 precision.
+func (a *Quat) Slerp(b *Quat, t big.Float) *Quat {
+	return (*Quat)(a.Vector().SLerpShortest(b.Vector(), t))
+}
@@ -61,18 +61,35 @@ func (a *Vector) DivVectors(bs ...*Vector) *Vector {
 	return Modify_V(a, DivOp, b...).(*Vector)
 }
 
+// Add a scalar value to each vector element
+func (a *Vector) AddScalar(val big.Float) *Vector {
+	return ModifyScalar_V(a, AddOp, Scalar(val)).(*Vector)
+}
+
+// Subtract a scalar value from each vector element
+func (a *Vector) SubScalar(val big.Float) *Vector {
+	return ModifyScalar_V(a, SubOp, Scalar(val)).(*Vector)
+}
+
 // Multiply a vector by a scalar value
 func (a *Vector) MulScalar(val big.Float) *Vector {
-	b := NewVector(1)
-	b.Elem[0] = Scalar(val)
-	return Modify_V(a, MulOp, b).(*Vector)
+	return ModifyScalar_V(a, MulOp, Scalar(val)).(*Vector)
 }
 
 // Divide a vector by a scalar value
 func (a *Vector) DivScalar(val big.Float) *Vector {
-	b := NewVector(1)
-	b.Elem[0] = Scalar(val)
-	return Modify_V(a, DivOp, b).(*Vector)
+	return ModifyScalar_V(a, DivOp, Scalar(val)).(*Vector)
+}
+
+// Raise each vector element to the power of a scalar value,
+// computed at float64 precision
+func (a *Vector) PowScalar(val big.Float) *Vector {
+	return Pow_V(a, Scalar(val)).(*Vector)
+}
+
+// Clamp each vector element to the range [lo, hi]
+func (a *Vector) ClampScalar(lo, hi big.Float) *Vector {
+	return Clamp_V(a, Scalar(lo), Scalar(hi)).(*Vector)
 }
 
 // Negate a vector
@@ -80,17 +97,142 @@ func (a *Vector) Negate() *Vector {
 	return Negate_V(a).(*Vector)
 }
 
+// Element-wise minimum of the receiver and a set of vectors
+func (a *Vector) MinVectors(bs ...*Vector) *Vector {
+	b := gen_V(bs...)
+	return Min_V(a, b...).(*Vector)
+}
+
+// Element-wise maximum of the receiver and a set of vectors
+func (a *Vector) MaxVectors(bs ...*Vector) *Vector {
+	b := gen_V(bs...)
+	return Max_V(a, b...).(*Vector)
+}
+
+// Absolute value of each vector element
+func (a *Vector) Abs() *Vector {
+	return Abs_V(a).(*Vector)
+}
+
+// Square root of each vector element
+func (a *Vector) Sqrt() *Vector {
+	return Sqrt_V(a).(*Vector)
+}
+
+// Round each vector element down
+func (a *Vector) Floor() *Vector {
+	return Floor_V(a).(*Vector)
+}
+
+// Round each vector element up
+func (a *Vector) Ceil() *Vector {
+	return Ceil_V(a).(*Vector)
+}
+
+// Round each vector element to the nearest integer, half away from zero
+func (a *Vector) Round() *Vector {
+	return Round_V(a).(*Vector)
+}
+
+// Replace each vector element e with fn(e)
+func (a *Vector) Map(fn func(big.Float) big.Float) *Vector {
+	return Map_V(a, func(e S) S { return Scalar(fn(big.Float(e.(Scalar)))) }).(*Vector)
+}
+
+// Replace each vector element e with fn(e, b[i]), where i is the element position
+func (a *Vector) ZipWith(b *Vector, fn func(big.Float, big.Float) big.Float) *Vector {
+	return ZipWith_V(a, b, func(e, f S) S { return Scalar(fn(big.Float(e.(Scalar)), big.Float(f.(Scalar)))) }).(*Vector)
+}
+
 // Dot product of two vectors
 func (a *Vector) Dot(b *Vector) big.Float {
 	return big.Float(Dot_V(a, b).(Scalar))
 }
 
+// Sum of the vector elements
+func (a *Vector) Sum() big.Float {
+	return big.Float(Sum_V(a).(Scalar))
+}
+
+// Product of the vector elements
+func (a *Vector) Product() big.Float {
+	return big.Float(Product_V(a).(Scalar))
+}
+
+// Norm of order p; see L1, L2 and LInf
+func (a *Vector) Norm(p float64) big.Float {
+	return big.Float(Norm_V(a, p).(Scalar))
+}
+
+// Distance between two vectors using the norm of order p
+func (a *Vector) Distance(b *Vector, p float64) big.Float {
+	return big.Float(Distance_V(a, b, p).(Scalar))
+}
+
+// Approximate equality: true where the vectors have the same dimension and
+// each pair of elements differs by at most absTol, or at most relTol times
+// the larger element magnitude
+func (a *Vector) ApproxEqual(b *Vector, absTol, relTol float64) bool {
+	return ApproxEqual_V(a, b, absTol, relTol)
+}
+
+// Cosine similarity of two vectors
+func (a *Vector) CosineSimilarity(b *Vector) big.Float {
+	return big.Float(CosineSimilarity_V(a, b).(Scalar))
+}
+
 // Linear interpolation
 func (a *Vector) Lerp(b *Vector, t big.Float) *Vector {
 	return Lerp_V(a, b, Scalar(t)).(*Vector)
 }
 
+// Normalized linear interpolation
+func (a *Vector) NLerp(b *Vector, t big.Float) *Vector {
+	return NLerp_V(a, b, Scalar(t)).(*Vector)
+}
+
 // Spherical Linear interpolation
 func (a *Vector) SLerp(b *Vector, t big.Float) *Vector {
 	return SLerp_V(a, b, Scalar(t)).(*Vector)
 }
+
+// Spherical Linear interpolation along the shortest arc
+func (a *Vector) SLerpShortest(b *Vector, t big.Float) *Vector {
+	return SLerpShortest_V(a, b, Scalar(t)).(*Vector)
+}
+
+// Normalize to unit length; a zero length vector is left unchanged
+func (a *Vector) Normalize() *Vector {
+	return Normalize_V(a).(*Vector)
+}
+
+// Cross product of two 3 element vectors
+func (a *Vector) Cross(b *Vector) *Vector {
+	return Cross3_V(a, b).(*Vector)
+}
+
+// Projection of the receiver onto b
+func (a *Vector) Project(b *Vector) *Vector {
+	return Project_V(a, b).(*Vector)
+}
+
+// Component of the receiver orthogonal to b
+func (a *Vector) Reject(b *Vector) *Vector {
+	return Reject_V(a, b).(*Vector)
+}
+
+// Reflection about the plane with the given unit normal
+func (a *Vector) Reflect(normal *Vector) *Vector {
+	return Reflect_V(a, normal).(*Vector)
+}
+
+// Refraction of the unit receiver through the surface with the given unit
+// normal, for the ratio of refractive indices eta
+func (a *Vector) Refract(normal *Vector, eta big.Float) *Vector {
+	return Refract_V(a, normal, Scalar(eta)).(*Vector)
+}
+
+// Angle, in radians, between two vectors
+func (a *Vector) Angle(b *Vector) big.Float {
+	return big.Float(Angle_V(a, b).(Scalar))
+}
@@ -25,7 +25,7 @@ type Scalar big.Float
 /////////////////////////////////////////////////////////////
 // Compile-time implementation prover
 var _ V = &Vector{}
-var _ S = &Scalar{}
+var _ S = Scalar{}
 
 /////////////////////////////////////////////////////////////
 // Type-specific support for generic implementation
@@ -42,69 +42,69 @@ func (a *Vector) Dup_V() V {
 	return a.CopyVector()
 }
 
-// Get
+// Get - returns a copy of the element
 func (a *Vector) Get_V(pos int) S {
-	return Scalar(a.Elem[pos])
+	return Scalar(*dup(a.elem(pos)))
 }
 
-// Set
+// Set - stores a copy of the given element
 func (a *Vector) Set_V(pos int, b S) {
-	a.Elem[pos] = b.(Scalar)
+	x := b.(Scalar)
+	a.Elem[pos] = Scalar(*dup(x.float()))
 }
 
 // Add
 func (a *Vector) Add_V(pos int, b V) {
-	x := big.Float(a.Elem[pos])
-	y := big.Float(b.(*Vector).Elem[pos])
-	x.Add(&x, &y)
-	a.Elem[pos] = Scalar(x)
+	x := a.elem(pos)
+	x.Add(x, b.(*Vector).elem(pos))
 }
 
 // Subtract
 func (a *Vector) Sub_V(pos int, b V) {
-	x := big.Float(a.Elem[pos])
-	y := big.Float(b.(*Vector).Elem[pos])
-	x.Sub(&x, &y)
-	a.Elem[pos] = Scalar(x)
+	x := a.elem(pos)
+	x.Sub(x, b.(*Vector).elem(pos))
 }
 
 // Multiply
 func (a *Vector) Mul_V(pos int, b V) {
-	x := big.Float(a.Elem[pos])
-	y := big.Float(b.(*Vector).Elem[pos])
-	x.Mul(&x, &y)
-	a.Elem[pos] = Scalar(x)
+	x := a.elem(pos)
+	x.Mul(x, b.(*Vector).elem(pos))
 }
 
 // Divide
 func (a *Vector) Div_V(pos int, b V) {
-	x := big.Float(a.Elem[pos])
-	y := big.Float(b.(*Vector).Elem[pos])
-	x.Quo(&x, &y)
-	a.Elem[pos] = Scalar(x)
+	x := a.elem(pos)
+	x.Quo(x, b.(*Vector).elem(pos))
+}
+
+// Add Scalar
+func (a *Vector) AddSc_V(pos int, b S) {
+	x, y := a.elem(pos), b.(Scalar)
+	x.Add(x, y.float())
+}
+
+// Subtract Scalar
+func (a *Vector) SubSc_V(pos int, b S) {
+	x, y := a.elem(pos), b.(Scalar)
+	x.Sub(x, y.float())
 }
 
 // Multiply Scalar
 func (a *Vector) MulSc_V(pos int, b S) {
-	x := big.Float(a.Elem[pos])
-	y := big.Float(b.(Scalar))
-	x.Mul(&x, &y)
-	a.Elem[pos] = Scalar(x)
+	x, y := a.elem(pos), b.(Scalar)
+	x.Mul(x, y.float())
 }
 
 // Divide Scalar
 func (a *Vector) DivSc_V(pos int, b S) {
-	x := big.Float(a.Elem[pos])
-	y := big.Float(b.(Scalar))
-	x.Quo(&x, &y)
-	a.Elem[pos] = Scalar(x)
+	x, y := a.elem(pos), b.(Scalar)
+	x.Quo(x, y.float())
 }
 
 // Negate a vector element
 func (a *Vector) Neg_V(pos int) {
-	x := big.Float(a.Elem[pos])
-	x.Neg(&x)
-	a.Elem[pos] = Scalar(x)
+	x := a.elem(pos)
+	x.Neg(x)
 }
 
 // Vector length
@@ -122,57 +122,138 @@ func (a *Vector) LenMin_V(b V) int {
 	return bl
 }
 
+// Zero valued element
+func (a *Vector) Zero_V() S {
+	return Scalar{}
+}
+
 // ... for the S API
+//
+// Scalar values share their mantissa with any shallow copy, so each
+// operation computes into a newly allocated big.Float.
 
 // Add
 func (a Scalar) Add_S(b S) S {
-	var x, y big.Float
-	x = big.Float(a)
-	y = big.Float(b.(Scalar))
-	z := x.Add(&x, &y)
-	return (Scalar)(*z)
+	y := b.(Scalar)
+	z := new(big.Float).Add(a.float(), y.float())
+	return Scalar(*z)
 }
 
 // Subtract
 func (a Scalar) Sub_S(b S) S {
-	var x, y big.Float
-	x = big.Float(a)
-	y = big.Float(b.(Scalar))
-	z := x.Sub(&x, &y)
-	return (Scalar)(*z)
+	y := b.(Scalar)
+	z := new(big.Float).Sub(a.float(), y.float())
+	return Scalar(*z)
 }
 
 // Multiply
 func (a Scalar) Mul_S(b S) S {
-	var x, y big.Float
-	x = big.Float(a)
-	y = big.Float(b.(Scalar))
-	z := x.Mul(&x, &y)
-	return (Scalar)(*z)
-
+	y := b.(Scalar)
+	z := new(big.Float).Mul(a.float(), y.float())
+	return Scalar(*z)
 }
 
 // Divide
 func (a Scalar) Div_S(b S) S {
-	var x, y big.Float
-	x = big.Float(a)
-	y = big.Float(b.(Scalar))
-	z := x.Quo(&x, &y)
-	return (Scalar)(*z)
+	y := b.(Scalar)
+	z := new(big.Float).Quo(a.float(), y.float())
+	return Scalar(*z)
+}
+
+// Absolute value
+func (a Scalar) Abs_S() S {
+	z := new(big.Float).Abs(a.float())
+	return Scalar(*z)
+}
+
+// Square root
+func (a Scalar) Sqrt_S() S {
+	z := new(big.Float).Sqrt(a.float())
+	return Scalar(*z)
+}
+
+// Floor
+func (a Scalar) Floor_S() S {
+	return a.round(-1)
+}
+
+// Ceiling
+func (a Scalar) Ceil_S() S {
+	return a.round(1)
+}
+
+// Round, half away from zero
+func (a Scalar) Round_S() S {
+	return a.round(0)
+}
+
+// Compare
+func (a Scalar) Compare_S(b S) int {
+	y := b.(Scalar)
+	return a.float().Cmp(y.float())
+}
+
+// Zero, at the precision of the receiver
+func (a Scalar) Zero_S() S {
+	z := new(big.Float).SetPrec(a.float().Prec())
+	return Scalar(*z)
+}
+
+// One, at the precision of the receiver
+func (a Scalar) One_S() S {
+	return a.ToS(1)
 }
 
 // Convert Scalar to a float
 func (a Scalar) ToFloat() float64 {
-	x := big.Float(a)
-	ret, _ := x.Float64()
+	ret, _ := a.float().Float64()
 	return ret
 }
 
-// Convert a float to a Scalar - receiver is ignored 
-// TODO: receiver should be vector
+// Convert a float to a Scalar, at the precision of the receiver
+// or, for a zero precision receiver, at float64 precision
 func (a Scalar) ToS(v float64) S {
-	x := big.NewFloat(v)
-	return Scalar(*x)
+	z := new(big.Float)
+	if prec := a.float().Prec(); prec > 0 {
+		z.SetPrec(prec)
+	}
+	z.SetFloat64(v)
+	return Scalar(*z)
+}
+
+// elem returns the element at the given position as a big.Float
+func (a *Vector) elem(pos int) *big.Float {
+	return (*big.Float)(&a.Elem[pos])
+}
+
+// float returns the scalar as a big.Float
+func (a *Scalar) float() *big.Float {
+	return (*big.Float)(a)
+}
+
+// round returns the receiver rounded down for a negative mode, up for a
+// positive mode, or otherwise to the nearest integer, half away from zero
+func (a Scalar) round(mode int) Scalar {
+	x := a.float()
+	if x.IsInf() || x.IsInt() {
+		return Scalar(*dup(x))
+	}
+	t, _ := x.Int(nil)
+	frac := new(big.Float).Sub(x, new(big.Float).SetInt(t))
+	step := big.NewInt(int64(x.Sign()))
+	switch {
+	case mode < 0 && x.Sign() < 0, mode > 0 && x.Sign() > 0:
+		t.Add(t, step)
+	case mode == 0 && frac.Abs(frac).Cmp(big.NewFloat(0.5)) >= 0:
+		t.Add(t, step)
+	}
+	z := new(big.Float).SetPrec(x.Prec()).SetInt(t)
+	return Scalar(*z)
+}
+
+// dup returns a copy of the given big.Float that does not share its mantissa
+func dup(x *big.Float) *big.Float {
+	return new(big.Float).Set(x)
 }
 
 // Helper function
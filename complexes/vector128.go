@@ -0,0 +1,210 @@
+// Copyright © 2015 Gerald Rosenberg.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the License.md file.
+//
+package complexes
+
+import (
+	"math/cmplx"
+
+	. "github.com/grosenberg/maths/algorithms"
+	"github.com/grosenberg/maths/floats"
+)
+
+/////////////////////////////////////////////////////////////
+// Vector type-specific API
+
+// Create a new Vector of the given dimension
+func NewVector128(dim int) *Vector128 {
+	v := &Vector128{}
+	v.Elem = make([]Scalar128, dim)
+	return v
+}
+
+// Create a copy of an existing Vector
+func (a *Vector128) CopyVector128() *Vector128 {
+	b := NewVector128(a.Len_V())
+	copy(b.Elem, a.Elem)
+	return b
+}
+
+// Create a Vector from the given real and imaginary parts
+func FromRect(re, im *floats.Vector64) *Vector128 {
+	v := NewVector128(re.LenMin_V(im))
+	for i := range v.Elem {
+		v.Elem[i] = Scalar128(complex(re.Elem[i], im.Elem[i]))
+	}
+	return v
+}
+
+// Create a Vector from the given moduli and arguments, in radians
+func FromPolar(r, theta *floats.Vector64) *Vector128 {
+	v := NewVector128(r.LenMin_V(theta))
+	for i := range v.Elem {
+		v.Elem[i] = Scalar128(cmplx.Rect(float64(r.Elem[i]), float64(theta.Elem[i])))
+	}
+	return v
+}
+
+// Rect returns the real and imaginary parts of the vector elements
+func (a *Vector128) Rect() (re, im *floats.Vector64) {
+	re, im = floats.NewVector64(a.Len_V()), floats.NewVector64(a.Len_V())
+	for i, z := range a.Elem {
+		re.Elem[i], im.Elem[i] = floats.Scalar64(real(z)), floats.Scalar64(imag(z))
+	}
+	return re, im
+}
+
+// Polar returns the moduli and arguments, in radians, of the vector elements
+func (a *Vector128) Polar() (r, theta *floats.Vector64) {
+	return a.Abs128(), a.Arg128()
+}
+
+// Add a set of vectors to the receiver vector
+func (a *Vector128) AddVectors128(bs ...*Vector128) *Vector128 {
+	b := gen_V(bs...)
+	return Modify_V(a, AddOp, b...).(*Vector128)
+}
+
+// Subtract a set of vectors from the receiver vector
+func (a *Vector128) SubVectors128(bs ...*Vector128) *Vector128 {
+	b := gen_V(bs...)
+	return Modify_V(a, SubOp, b...).(*Vector128)
+}
+
+// Multiply a set of vectors against the receiver vector
+func (a *Vector128) MulVectors128(bs ...*Vector128) *Vector128 {
+	b := gen_V(bs...)
+	return Modify_V(a, MulOp, b...).(*Vector128)
+}
+
+// Divide a set of vectors against the receiver vector
+func (a *Vector128) DivVectors128(bs ...*Vector128) *Vector128 {
+	b := gen_V(bs...)
+	return Modify_V(a, DivOp, b...).(*Vector128)
+}
+
+// Add a scalar value to each vector element
+func (a *Vector128) AddScalar128(val complex128) *Vector128 {
+	return ModifyScalar_V(a, AddOp, Scalar128(val)).(*Vector128)
+}
+
+// Subtract a scalar value from each vector element
+func (a *Vector128) SubScalar128(val complex128) *Vector128 {
+	return ModifyScalar_V(a, SubOp, Scalar128(val)).(*Vector128)
+}
+
+// Multiply a vector by a scalar value
+func (a *Vector128) MulScalar128(val complex128) *Vector128 {
+	return ModifyScalar_V(a, MulOp, Scalar128(val)).(*Vector128)
+}
+
+// Divide a vector by a scalar value
+func (a *Vector128) DivScalar128(val complex128) *Vector128 {
+	return ModifyScalar_V(a, DivOp, Scalar128(val)).(*Vector128)
+}
+
+// Negate a vector
+func (a *Vector128) Negate128() *Vector128 {
+	return Negate_V(a).(*Vector128)
+}
+
+// Principal square root of each vector element
+func (a *Vector128) Sqrt128() *Vector128 {
+	return Sqrt_V(a).(*Vector128)
+}
+
+// Round the real and imaginary parts of each vector element down
+func (a *Vector128) Floor128() *Vector128 {
+	return Floor_V(a).(*Vector128)
+}
+
+// Round the real and imaginary parts of each vector element up
+func (a *Vector128) Ceil128() *Vector128 {
+	return Ceil_V(a).(*Vector128)
+}
+
+// Round the real and imaginary parts of each vector element to the
+// nearest integer, half away from zero
+func (a *Vector128) Round128() *Vector128 {
+	return Round_V(a).(*Vector128)
+}
+
+// Replace each vector element e with fn(e)
+func (a *Vector128) Map128(fn func(complex128) complex128) *Vector128 {
+	return Map_V(a, func(e S) S { return Scalar128(fn(complex128(e.(Scalar128)))) }).(*Vector128)
+}
+
+// Replace each vector element e with fn(e, b[i]), where i is the element position
+func (a *Vector128) ZipWith128(b *Vector128, fn func(complex128, complex128) complex128) *Vector128 {
+	return ZipWith_V(a, b, func(e, f S) S { return Scalar128(fn(complex128(e.(Scalar128)), complex128(f.(Scalar128)))) }).(*Vector128)
+}
+
+// Conjugate each vector element
+func (a *Vector128) Conj128() *Vector128 {
+	for i, z := range a.Elem {
+		a.Elem[i] = Scalar128(cmplx.Conj(complex128(z)))
+	}
+	return a
+}
+
+// Abs returns the modulus of each vector element
+func (a *Vector128) Abs128() *floats.Vector64 {
+	b := floats.NewVector64(a.Len_V())
+	for i, z := range a.Elem {
+		b.Elem[i] = floats.Scalar64(cmplx.Abs(complex128(z)))
+	}
+	return b
+}
+
+// Arg returns the argument, in radians, of each vector element
+func (a *Vector128) Arg128() *floats.Vector64 {
+	b := floats.NewVector64(a.Len_V())
+	for i, z := range a.Elem {
+		b.Elem[i] = floats.Scalar64(cmplx.Phase(complex128(z)))
+	}
+	return b
+}
+
+// Bilinear dot product of two vectors, without conjugation
+func (a *Vector128) Dot128(b *Vector128) complex128 {
+	return complex128(Dot_V(a, b).(Scalar128))
+}
+
+// Hermitian dot product of two vectors, conjugating the receiver:
+// the sum of conj(a[i]) * b[i]
+func (a *Vector128) HermitianDot128(b *Vector128) complex128 {
+	return a.CopyVector128().Conj128().Dot128(b)
+}
+
+// Sum of the vector elements
+func (a *Vector128) Sum128() complex128 {
+	return complex128(Sum_V(a).(Scalar128))
+}
+
+// Product of the vector elements
+func (a *Vector128) Product128() complex128 {
+	return complex128(Product_V(a).(Scalar128))
+}
+
+// Norm of order p, computed on the element moduli; see L1, L2 and LInf
+func (a *Vector128) Norm128(p float64) float64 {
+	return Norm_V(a, p).ToFloat()
+}
+
+// Distance between two vectors using the norm of order p
+func (a *Vector128) Distance128(b *Vector128, p float64) float64 {
+	return Distance_V(a, b, p).ToFloat()
+}
+
+// Approximate equality: true where the vectors have the same dimension and
+// each pair of elements differs by at most absTol, or at most relTol times
+// the larger element magnitude
+func (a *Vector128) ApproxEqual128(b *Vector128, absTol, relTol float64) bool {
+	return ApproxEqual_V(a, b, absTol, relTol)
+}
+
+// Linear interpolation
+func (a *Vector128) Lerp128(b *Vector128, t complex128) *Vector128 {
+	return Lerp_V(a, b, Scalar128(t)).(*Vector128)
+}
@@ -0,0 +1,213 @@
+// Copyright © 2015 Gerald Rosenberg.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the License.md file.
+//
+package complexes
+
+import (
+	"cmp"
+	"math"
+	"math/cmplx"
+	"sync"
+
+	. "github.com/grosenberg/maths/algorithms"
+)
+
+// Type specfic composite 'value' compatible with the
+// intended generic type algorithm implemenetation.
+type Vector128 struct {
+	sync.Mutex
+	Elem []Scalar128
+}
+
+// Type specfic simple 'value' compatible with the
+// intended generic type algorithm implemenetation.
+type Scalar128 complex128
+
+/////////////////////////////////////////////////////////////
+// Compile-time implementation prover
+var _ V = &Vector128{}
+var _ S = Scalar128(0)
+var _ C = Scalar128(0)
+
+/////////////////////////////////////////////////////////////
+// Type-specific support for generic implementation
+
+// ... for the V API
+
+// New
+func (a *Vector128) New_V() V {
+	return NewVector128(a.Len_V())
+}
+
+// Dup (copy)
+func (a *Vector128) Dup_V() V {
+	return a.CopyVector128()
+}
+
+// Get
+func (a *Vector128) Get_V(pos int) S {
+	return a.Elem[pos]
+}
+
+// Set
+func (a *Vector128) Set_V(pos int, b S) {
+	a.Elem[pos] = b.(Scalar128)
+}
+
+// Add
+func (a *Vector128) Add_V(pos int, b V) {
+	a.Elem[pos] += b.(*Vector128).Elem[pos]
+}
+
+// Subtract
+func (a *Vector128) Sub_V(pos int, b V) {
+	a.Elem[pos] -= b.(*Vector128).Elem[pos]
+}
+
+// Multiply
+func (a *Vector128) Mul_V(pos int, b V) {
+	a.Elem[pos] *= b.(*Vector128).Elem[pos]
+}
+
+// Divide
+func (a *Vector128) Div_V(pos int, b V) {
+	a.Elem[pos] /= b.(*Vector128).Elem[pos]
+}
+
+// Add Scalar
+func (a *Vector128) AddSc_V(pos int, b S) {
+	a.Elem[pos] += b.(Scalar128)
+}
+
+// Subtract Scalar
+func (a *Vector128) SubSc_V(pos int, b S) {
+	a.Elem[pos] -= b.(Scalar128)
+}
+
+// Multiply Scalar
+func (a *Vector128) MulSc_V(pos int, b S) {
+	a.Elem[pos] *= b.(Scalar128)
+}
+
+// Divide Scalar
+func (a *Vector128) DivSc_V(pos int, b S) {
+	a.Elem[pos] /= b.(Scalar128)
+}
+
+// Negate a vector element
+func (a *Vector128) Neg_V(pos int) {
+	a.Elem[pos] = -a.Elem[pos]
+}
+
+// Vector length
+func (a *Vector128) Len_V() int {
+	return len(a.Elem)
+}
+
+// Minimum relative vector length
+func (a *Vector128) LenMin_V(b V) int {
+	al := len(a.Elem)
+	bl := len(b.(*Vector128).Elem)
+	if al < bl {
+		return al
+	}
+	return bl
+}
+
+// Zero valued element
+func (a *Vector128) Zero_V() S {
+	return Scalar128(0)
+}
+
+// ... for the S API
+
+// Add
+func (a Scalar128) Add_S(b S) S {
+	return a + b.(Scalar128)
+}
+
+// Subtract
+func (a Scalar128) Sub_S(b S) S {
+	return a - b.(Scalar128)
+}
+
+// Multiply
+func (a Scalar128) Mul_S(b S) S {
+	return a * b.(Scalar128)
+}
+
+// Divide
+func (a Scalar128) Div_S(b S) S {
+	return a / b.(Scalar128)
+}
+
+// Absolute value - the modulus, as a real valued Scalar
+func (a Scalar128) Abs_S() S {
+	return Scalar128(complex(cmplx.Abs(complex128(a)), 0))
+}
+
+// Square root - the principal square root
+func (a Scalar128) Sqrt_S() S {
+	return Scalar128(cmplx.Sqrt(complex128(a)))
+}
+
+// Floor - of the real and imaginary parts
+func (a Scalar128) Floor_S() S {
+	return Scalar128(complex(math.Floor(real(a)), math.Floor(imag(a))))
+}
+
+// Ceiling - of the real and imaginary parts
+func (a Scalar128) Ceil_S() S {
+	return Scalar128(complex(math.Ceil(real(a)), math.Ceil(imag(a))))
+}
+
+// Round, half away from zero - of the real and imaginary parts
+func (a Scalar128) Round_S() S {
+	return Scalar128(complex(math.Round(real(a)), math.Round(imag(a))))
+}
+
+// Compare - by real and then imaginary part
+func (a Scalar128) Compare_S(b S) int {
+	y := b.(Scalar128)
+	if c := cmp.Compare(real(a), real(y)); c != 0 {
+		return c
+	}
+	return cmp.Compare(imag(a), imag(y))
+}
+
+// Imaginary part, as a real valued scalar
+func (a Scalar128) Imag_S() S {
+	return Scalar128(complex(imag(a), 0))
+}
+
+// Zero - receiver is ignored
+func (a Scalar128) Zero_S() S {
+	return Scalar128(0)
+}
+
+// One - receiver is ignored
+func (a Scalar128) One_S() S {
+	return Scalar128(1)
+}
+
+// Convert Scalar to a float - the real part
+func (a Scalar128) ToFloat() float64 {
+	return real(a)
+}
+
+// Convert a float to a real valued Scalar - receiver is ignored
+func (a Scalar128) ToS(v float64) S {
+	return Scalar128(complex(v, 0))
+}
+
+// Helper function
+
+// gen_V promotes the base type to []V
+func gen_V(bi ...*Vector128) []V {
+	b := make([]V, len(bi))
+	for i, v := range bi {
+		b[i] = v
+	}
+	return b
+}
@@ -0,0 +1,85 @@
+// Copyright © 2015 Gerald Rosenberg.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the License.md file.
+//
+package floats
+
+import (
+	"fmt"
+	"math"
+
+	. "github.com/grosenberg/maths/algorithms"
+)
+
+/////////////////////////////////////////////////////////////
+// EWMARegister type-specific implementations
+
+// NewEWMARegister creates a new EWMARegister that computes the exponentially
+// weighted moving average of the values contributed, where each value is
+// weighted by (1 - alpha) relative to the next. The alpha is in (0, 1]; an
+// alpha of 1 computes the last value contributed. Panics if alpha is not
+// in (0, 1].
+//
+// The average is bias-corrected: the weighted sum of the values is divided
+// by the sum of their weights, so that it is not biased toward the first
+// value. It therefore differs from the conventional y = alpha*x + (1-alpha)*y
+// seeded with the first value: for an alpha of 0.5 over 1, 2 and 3, it is
+// 17/7 rather than 2.25.
+func NewEWMARegister(alpha float64) *EWMARegister {
+	if !(alpha > 0 && alpha <= 1) {
+		panic(fmt.Sprintf("floats: EWMARegister alpha %v not in (0, 1]", alpha))
+	}
+	return &EWMARegister{alpha: alpha}
+}
+
+// NewEWMARegisterHalfLife creates a new EWMARegister where the weight of
+// each value halves after the given number of further value contributions.
+// Panics if the half-life is not positive and finite.
+func NewEWMARegisterHalfLife(halfLife float64) *EWMARegister {
+	if !(halfLife > 0) || math.IsInf(halfLife, 1) {
+		panic(fmt.Sprintf("floats: EWMARegister half-life %v not positive and finite", halfLife))
+	}
+	return NewEWMARegister(1 - math.Exp2(-1/halfLife))
+}
+
+// Alpha returns the smoothing factor of the register.
+func (reg *EWMARegister) Alpha() float64 {
+	return reg.alpha
+}
+
+// Accumulate adds the given values to the register values and
+// returns the current count of value contributions.
+func (reg *EWMARegister) Accumulate(b ...float64) int {
+	reg.Lock()
+	defer reg.Unlock()
+
+	return Accumulate_R(reg, b).(int)
+}
+
+// Merge adds the contributions of the given registers, in order, as
+// following the register values and returns the current count of value
+// contributions. The given registers are unchanged, and must have the same
+// alpha as the register.
+func (reg *EWMARegister) Merge(others ...*EWMARegister) int {
+	o := make([]R, len(others))
+	for i, r := range others {
+		o[i] = r
+	}
+	return MergeAll_R(reg, o...).(int)
+}
+
+// Compute updates and returns the calculated value
+func (reg *EWMARegister) Compute() float64 {
+	reg.Lock()
+	defer reg.Unlock()
+
+	return Compute_R(reg).(float64)
+}
+
+// Reset clears the register values and returns the prior calculated value
+func (reg *EWMARegister) Reset() float64 {
+	reg.Lock()
+	defer reg.Unlock()
+
+	return Reset_R(reg).(float64)
+}
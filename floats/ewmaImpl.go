@@ -0,0 +1,88 @@
+// Copyright © 2015 Gerald Rosenberg.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the License.md file.
+//
+package floats
+
+import (
+	"fmt"
+	"math"
+	"sync"
+
+	. "github.com/grosenberg/maths/algorithms"
+)
+
+// Type specfic composite 'value' compatible with the
+// intended generic type algorithm implemenetation.
+//
+// The average is kept as a decayed sum of the values and a decayed sum of
+// their weights. Their ratio is the bias-corrected average, which is not
+// skewed toward zero by the initial value, and the sums of two registers
+// combine exactly.
+type EWMARegister struct {
+	sync.Mutex
+	reg    float64 // store for the final (or current) computed value
+	alpha  float64 // smoothing factor
+	sum    float64 // decayed sum of the values
+	weight float64 // decayed sum of the weights
+	count  int     // contribution counter
+}
+
+/////////////////////////////////////////////////////////////
+// Compile-time implementation prover
+var _ R = &EWMARegister{}
+
+/////////////////////////////////////////////////////////////
+// Type-specific support for generic implementation
+
+// New creates a new empty EWMARegister of the same alpha.
+func (r *EWMARegister) New_R() R {
+	return NewEWMARegister(r.alpha)
+}
+
+// Add decays the prior values and adds the value of the given parameter,
+// modifying the receiver.
+func (r *EWMARegister) Add_R(q Q) {
+	d := 1 - r.alpha
+	r.sum = d*r.sum + q.(float64)
+	r.weight = d*r.weight + 1
+	r.count++
+}
+
+// Merge decays the values of the receiver by the contributions of the given
+// register and adds its values, modifying the receiver. Panics if the
+// registers have different alphas.
+func (r *EWMARegister) Merge_R(o R) {
+	b := o.(*EWMARegister)
+	if b.alpha != r.alpha {
+		panic(fmt.Sprintf("floats: merge of EWMARegister alpha %v into alpha %v", b.alpha, r.alpha))
+	}
+	d := math.Pow(1-r.alpha, float64(b.count))
+	r.sum = d*r.sum + b.sum
+	r.weight = d*r.weight + b.weight
+	r.count += b.count
+}
+
+// Update computes and stores the current average, modifying the receiver.
+func (r *EWMARegister) Update_R() {
+	if r.weight == 0 {
+		r.reg = 0
+		return
+	}
+	r.reg = r.sum / r.weight
+}
+
+func (r *EWMARegister) Value_R() Q {
+	return r.reg
+}
+
+func (r *EWMARegister) Count_R() Q {
+	return r.count
+}
+
+func (r *EWMARegister) Clear_R() {
+	r.reg = 0
+	r.sum = 0
+	r.weight = 0
+	r.count = 0
+}
@@ -0,0 +1,150 @@
+// Copyright © 2015 Gerald Rosenberg.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the License.md file.
+//
+package floats
+
+import (
+	"math"
+	"sort"
+
+	. "github.com/grosenberg/maths/algorithms"
+)
+
+/////////////////////////////////////////////////////////////
+// HistogramRegister type-specific implementations
+
+// Histogram bucket counting the values in [Lo, Hi)
+type Bucket struct {
+	Lo, Hi float64
+	Count  int
+}
+
+// NewHistogramRegister creates a new HistogramRegister with buckets between
+// each pair of adjacent given boundaries. Values below the first boundary are
+// counted as underflow; values at or above the last boundary as overflow.
+// The boundaries are sorted, and duplicates ignored.
+func NewHistogramRegister(bounds ...float64) *HistogramRegister {
+	b := append([]float64(nil), bounds...)
+	sort.Float64s(b)
+	n := 0
+	for i, x := range b {
+		if i == 0 || x != b[n-1] {
+			b[n] = x
+			n++
+		}
+	}
+	b = b[:n]
+	cnt := 0
+	if n > 1 {
+		cnt = n - 1
+	}
+	return &HistogramRegister{bounds: b, counts: make([]int, cnt)}
+}
+
+// NewFixedWidthHistogramRegister creates a new HistogramRegister with n
+// buckets of the given width, starting at lo.
+func NewFixedWidthHistogramRegister(lo, width float64, n int) *HistogramRegister {
+	b := make([]float64, n+1)
+	for i := range b {
+		b[i] = lo + float64(i)*width
+	}
+	return NewHistogramRegister(b...)
+}
+
+// NewExponentialHistogramRegister creates a new HistogramRegister with n
+// buckets, starting at start, where each boundary is the given factor
+// times the previous.
+func NewExponentialHistogramRegister(start, factor float64, n int) *HistogramRegister {
+	b := make([]float64, n+1)
+	for i := range b {
+		b[i] = start * math.Pow(factor, float64(i))
+	}
+	return NewHistogramRegister(b...)
+}
+
+// Accumulate adds the given values to the register values and
+// returns the current count of value contributions.
+func (reg *HistogramRegister) Accumulate(b ...float64) int {
+	reg.Lock()
+	defer reg.Unlock()
+
+	return Accumulate_R(reg, b).(int)
+}
+
+// AccumulateParallel adds the given values to the register values using the
+// given number of workers, or GOMAXPROCS workers where not positive, and
+// returns the current count of value contributions.
+func (reg *HistogramRegister) AccumulateParallel(b []float64, workers int) int {
+	reg.Lock()
+	defer reg.Unlock()
+
+	return AccumulateParallel_R(reg, b, workers).(int)
+}
+
+// Merge adds the contributions of the given registers, in order, to the
+// register values and returns the current count of value contributions.
+// The given registers are unchanged, and must have the same boundaries
+// as the register.
+func (reg *HistogramRegister) Merge(others ...*HistogramRegister) int {
+	o := make([]R, len(others))
+	for i, r := range others {
+		o[i] = r
+	}
+	return MergeAll_R(reg, o...).(int)
+}
+
+// Compute updates and returns the mean of the values
+func (reg *HistogramRegister) Compute() float64 {
+	reg.Lock()
+	defer reg.Unlock()
+
+	return Compute_R(reg).(float64)
+}
+
+// Reset clears the register values and returns the prior calculated value.
+// The boundaries are retained.
+func (reg *HistogramRegister) Reset() float64 {
+	reg.Lock()
+	defer reg.Unlock()
+
+	return Reset_R(reg).(float64)
+}
+
+// Buckets returns the buckets and their current counts, in order
+func (reg *HistogramRegister) Buckets() []Bucket {
+	reg.Lock()
+	defer reg.Unlock()
+
+	res := make([]Bucket, len(reg.counts))
+	for i, c := range reg.counts {
+		res[i] = Bucket{reg.bounds[i], reg.bounds[i+1], c}
+	}
+	return res
+}
+
+// Underflow returns the count of values below the first boundary
+func (reg *HistogramRegister) Underflow() int {
+	reg.Lock()
+	defer reg.Unlock()
+
+	return reg.under
+}
+
+// Overflow returns the count of values at or above the last boundary
+func (reg *HistogramRegister) Overflow() int {
+	reg.Lock()
+	defer reg.Unlock()
+
+	return reg.over
+}
+
+// CDF returns the estimated fraction of the values at or below x. The values
+// are taken as uniformly distributed within each bucket, and within the
+// underflow and overflow ranges bounded by the minimum and maximum values.
+func (reg *HistogramRegister) CDF(x float64) float64 {
+	reg.Lock()
+	defer reg.Unlock()
+
+	return reg.cdf(x)
+}
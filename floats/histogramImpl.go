@@ -0,0 +1,165 @@
+// Copyright © 2015 Gerald Rosenberg.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the License.md file.
+//
+package floats
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	. "github.com/grosenberg/maths/algorithms"
+)
+
+// Type specfic composite 'value' compatible with the
+// intended generic type algorithm implemenetation.
+type HistogramRegister struct {
+	sync.Mutex
+	reg      float64   // store for the final (or current) computed value
+	bounds   []float64 // ascending bucket boundaries
+	counts   []int     // bucket counts; bucket i is [bounds[i], bounds[i+1])
+	under    int       // count of values below the first boundary
+	over     int       // count of values at or above the last boundary
+	accum    float64   // accumulator for the mean
+	count    int       // contribution counter
+	min, max float64
+}
+
+/////////////////////////////////////////////////////////////
+// Compile-time implementation prover
+var _ R = &HistogramRegister{}
+
+/////////////////////////////////////////////////////////////
+// Type-specific support for generic implementation
+
+// New creates a new empty HistogramRegister of the same boundaries.
+func (r *HistogramRegister) New_R() R {
+	return &HistogramRegister{bounds: r.bounds, counts: make([]int, len(r.counts))}
+}
+
+// Add counts the value of the given parameter in its bucket, modifying the receiver.
+func (r *HistogramRegister) Add_R(q Q) {
+	x := q.(float64)
+	if r.count == 0 || x < r.min {
+		r.min = x
+	}
+	if r.count == 0 || x > r.max {
+		r.max = x
+	}
+	r.accum += x
+	r.count++
+
+	i := sort.Search(len(r.bounds), func(i int) bool { return r.bounds[i] > x }) - 1
+	switch {
+	case i < 0:
+		r.under++
+	case i >= len(r.counts):
+		r.over++
+	default:
+		r.counts[i]++
+	}
+}
+
+// Merge adds the counts of the given register, modifying the receiver.
+// Panics if the registers have different boundaries.
+func (r *HistogramRegister) Merge_R(o R) {
+	b := o.(*HistogramRegister)
+	if !sameBounds(r.bounds, b.bounds) {
+		panic(fmt.Sprintf("floats: merge of HistogramRegister bounds %v into bounds %v", b.bounds, r.bounds))
+	}
+	if b.count == 0 {
+		return
+	}
+	if r.count == 0 || b.min < r.min {
+		r.min = b.min
+	}
+	if r.count == 0 || b.max > r.max {
+		r.max = b.max
+	}
+	for i, c := range b.counts {
+		r.counts[i] += c
+	}
+	r.under += b.under
+	r.over += b.over
+	r.accum += b.accum
+	r.count += b.count
+}
+
+// Update computes and stores the current average, modifying the receiver.
+func (r *HistogramRegister) Update_R() {
+	if r.count == 0 {
+		r.reg = 0
+		return
+	}
+	r.reg = r.accum / float64(r.count)
+}
+
+func (r *HistogramRegister) Value_R() Q {
+	return r.reg
+}
+
+func (r *HistogramRegister) Count_R() Q {
+	return r.count
+}
+
+func (r *HistogramRegister) Clear_R() {
+	r.reg = 0
+	for i := range r.counts {
+		r.counts[i] = 0
+	}
+	r.under, r.over = 0, 0
+	r.accum = 0
+	r.count = 0
+	r.min, r.max = 0, 0
+}
+
+// Helper functions
+
+// cdf returns the estimated fraction of the values at or below x.
+func (r *HistogramRegister) cdf(x float64) float64 {
+	if r.count == 0 || x < r.min {
+		return 0
+	}
+	if x >= r.max {
+		return 1
+	}
+	// the underflow and overflow ranges are bounded by the minimum and maximum
+	n := len(r.bounds)
+	if n == 0 || x < r.bounds[0] {
+		hi := r.max
+		if n > 0 {
+			hi = r.bounds[0]
+		}
+		return float64(r.under) * fraction(x, r.min, hi) / float64(r.count)
+	}
+	below := float64(r.under)
+	for i, c := range r.counts {
+		if x < r.bounds[i+1] {
+			return (below + float64(c)*fraction(x, r.bounds[i], r.bounds[i+1])) / float64(r.count)
+		}
+		below += float64(c)
+	}
+	return (below + float64(r.over)*fraction(x, r.bounds[n-1], r.max)) / float64(r.count)
+}
+
+// fraction returns the fraction of the range [lo, hi] at or below x.
+func fraction(x, lo, hi float64) float64 {
+	if hi <= lo {
+		return 1
+	}
+	return (x - lo) / (hi - lo)
+}
+
+// sameBounds reports whether the given boundaries are equal.
+func sameBounds(a, b []float64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,236 @@
+// Copyright © 2015 Gerald Rosenberg.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the License.md file.
+//
+package floats
+
+import (
+	"math"
+
+	. "github.com/grosenberg/maths/algorithms"
+)
+
+/////////////////////////////////////////////////////////////
+// Fixed-size matrix type-specific API
+//
+// The transforms follow the OpenGL conventions: right-handed coordinates,
+// column vectors transformed as Mv, and a clip space depth range of [-1, 1].
+// As values, operations return a new matrix and never modify the receiver.
+
+/////////////////////////////////////////////////////////////
+// Mat3
+
+// Create a Mat3 from the given values in row-major order
+func Mat3FromRows(vals ...float32) Mat3 {
+	var a Mat3
+	for i := 0; i < len(vals) && i < 9; i++ {
+		a[(i%3)*3+i/3] = vals[i]
+	}
+	return a
+}
+
+// Identity Mat3
+func Ident3() Mat3 {
+	return Mat3{1, 0, 0, 0, 1, 0, 0, 0, 1}
+}
+
+// Scale by the given per-axis factors
+func Scale3(v Vec3) Mat3 {
+	return Mat3{v[X], 0, 0, 0, v[Y], 0, 0, 0, v[Z]}
+}
+
+// Rotation by angle radians about the given axis
+func Rotate3(axis Vec3, angle float32) Mat3 {
+	a := axis.Normalize()
+	x, y, z := float64(a[X]), float64(a[Y]), float64(a[Z])
+	s, c := math.Sincos(float64(angle))
+	k := 1 - c
+	return Mat3FromRows(
+		float32(x*x*k+c), float32(x*y*k-z*s), float32(x*z*k+y*s),
+		float32(y*x*k+z*s), float32(y*y*k+c), float32(y*z*k-x*s),
+		float32(z*x*k-y*s), float32(z*y*k+x*s), float32(z*z*k+c),
+	)
+}
+
+// Element at the given row and column
+func (a Mat3) At(row, col int) float32 {
+	return a[col*3+row]
+}
+
+// Matrix product
+func (a Mat3) Mul(b Mat3) Mat3 {
+	m, _ := MatMul_M(&a, &b)
+	return *m.(*Mat3)
+}
+
+// Transpose
+func (a Mat3) Transpose() Mat3 {
+	return *Transpose_M(&a).(*Mat3)
+}
+
+// Inverse, using DefaultTol32
+func (a Mat3) Inverse() (Mat3, error) {
+	m, err := Inverse_M(&a, DefaultTol32)
+	if err != nil {
+		return Mat3{}, err
+	}
+	return *m.(*Mat3), nil
+}
+
+// Transform the vector v
+func (a Mat3) Transform(v Vec3) Vec3 {
+	r, _ := MatVec_M(&a, v.Vector32())
+	return Vec3FromVector32(r.(*Vector32))
+}
+
+// Transform the 3 element vector v
+func (a Mat3) Transform32(v *Vector32) *Vector32 {
+	return a.Transform(Vec3FromVector32(v)).Vector32()
+}
+
+// Mat4 with the receiver as its upper left 3 by 3 matrix
+func (a Mat3) Mat4() Mat4 {
+	return Mat4{
+		a[0], a[1], a[2], 0,
+		a[3], a[4], a[5], 0,
+		a[6], a[7], a[8], 0,
+		0, 0, 0, 1,
+	}
+}
+
+/////////////////////////////////////////////////////////////
+// Mat4
+
+// Create a Mat4 from the given values in row-major order
+func Mat4FromRows(vals ...float32) Mat4 {
+	var a Mat4
+	for i := 0; i < len(vals) && i < 16; i++ {
+		a[(i%4)*4+i/4] = vals[i]
+	}
+	return a
+}
+
+// Identity Mat4
+func Ident4() Mat4 {
+	return Mat4{1, 0, 0, 0, 0, 1, 0, 0, 0, 0, 1, 0, 0, 0, 0, 1}
+}
+
+// Translation by v
+func Translate4(v Vec3) Mat4 {
+	a := Ident4()
+	a[12], a[13], a[14] = v[X], v[Y], v[Z]
+	return a
+}
+
+// Scale by the given per-axis factors
+func Scale4(v Vec3) Mat4 {
+	return Scale3(v).Mat4()
+}
+
+// Rotation by angle radians about the given axis
+func Rotate4(axis Vec3, angle float32) Mat4 {
+	return Rotate3(axis, angle).Mat4()
+}
+
+// Viewing transform for an eye at the given position looking toward center,
+// with the given up direction
+func LookAt(eye, center, up Vec3) Mat4 {
+	f := center.Sub(eye).Normalize()
+	s := f.Cross(up).Normalize()
+	u := s.Cross(f)
+	return Mat4FromRows(
+		s[X], s[Y], s[Z], -s.Dot(eye),
+		u[X], u[Y], u[Z], -u.Dot(eye),
+		-f[X], -f[Y], -f[Z], f.Dot(eye),
+		0, 0, 0, 1,
+	)
+}
+
+// Perspective projection for the given vertical field of view, in radians,
+// aspect ratio (width / height), and near and far clipping distances
+func Perspective(fovy, aspect, near, far float32) Mat4 {
+	top := near * float32(math.Tan(float64(fovy)/2))
+	right := top * aspect
+	return Frustum(-right, right, -top, top, near, far)
+}
+
+// Perspective projection for the given near clipping plane bounds and
+// near and far clipping distances
+func Frustum(left, right, bottom, top, near, far float32) Mat4 {
+	return Mat4FromRows(
+		2*near/(right-left), 0, (right+left)/(right-left), 0,
+		0, 2*near/(top-bottom), (top+bottom)/(top-bottom), 0,
+		0, 0, -(far+near)/(far-near), -2*far*near/(far-near),
+		0, 0, -1, 0,
+	)
+}
+
+// Orthographic projection for the given clipping plane bounds
+func Orthographic(left, right, bottom, top, near, far float32) Mat4 {
+	return Mat4FromRows(
+		2/(right-left), 0, 0, -(right+left)/(right-left),
+		0, 2/(top-bottom), 0, -(top+bottom)/(top-bottom),
+		0, 0, -2/(far-near), -(far+near)/(far-near),
+		0, 0, 0, 1,
+	)
+}
+
+// Element at the given row and column
+func (a Mat4) At(row, col int) float32 {
+	return a[col*4+row]
+}
+
+// Matrix product
+func (a Mat4) Mul(b Mat4) Mat4 {
+	m, _ := MatMul_M(&a, &b)
+	return *m.(*Mat4)
+}
+
+// Transpose
+func (a Mat4) Transpose() Mat4 {
+	return *Transpose_M(&a).(*Mat4)
+}
+
+// Inverse, using DefaultTol32
+func (a Mat4) Inverse() (Mat4, error) {
+	m, err := Inverse_M(&a, DefaultTol32)
+	if err != nil {
+		return Mat4{}, err
+	}
+	return *m.(*Mat4), nil
+}
+
+// Upper left 3 by 3 matrix
+func (a Mat4) Mat3() Mat3 {
+	return Mat3{a[0], a[1], a[2], a[4], a[5], a[6], a[8], a[9], a[10]}
+}
+
+// Transform the homogeneous vector v
+func (a Mat4) TransformVec4(v Vec4) Vec4 {
+	r, _ := MatVec_M(&a, v.Vector32())
+	return Vec4FromVector32(r.(*Vector32))
+}
+
+// Transform the point p, applying translation and the perspective divide
+func (a Mat4) TransformPoint(p Vec3) Vec3 {
+	r := a.TransformVec4(p.XYZ1())
+	if w := r[W]; w != 0 && w != 1 {
+		return r.XYZ().Scale(1 / w)
+	}
+	return r.XYZ()
+}
+
+// Transform the direction d, ignoring translation
+func (a Mat4) TransformDirection(d Vec3) Vec3 {
+	return a.TransformVec4(d.XYZ0()).XYZ()
+}
+
+// Transform the 3 element point p, applying translation and the perspective divide
+func (a Mat4) TransformPoint32(p *Vector32) *Vector32 {
+	return a.TransformPoint(Vec3FromVector32(p)).Vector32()
+}
+
+// Transform the 3 element direction d, ignoring translation
+func (a Mat4) TransformDirection32(d *Vector32) *Vector32 {
+	return a.TransformDirection(Vec3FromVector32(d)).Vector32()
+}
@@ -0,0 +1,106 @@
+// Copyright © 2015 Gerald Rosenberg.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the License.md file.
+//
+package floats
+
+import . "github.com/grosenberg/maths/algorithms"
+
+// Fixed-size value matrices, stored in column-major order: the element at
+// row r and column c is at index c*n+r.
+type (
+	Mat3 [9]float32
+	Mat4 [16]float32
+)
+
+/////////////////////////////////////////////////////////////
+// Compile-time implementation prover
+var (
+	_ M = &Mat3{}
+	_ M = &Mat4{}
+)
+
+/////////////////////////////////////////////////////////////
+// Type-specific support for generic implementation
+
+// ... for the Mat3 M API
+
+// New - a Mat3 where 3 by 3, otherwise a Matrix32
+func (a *Mat3) New_M(rows, cols int) M {
+	if rows == 3 && cols == 3 {
+		return new(Mat3)
+	}
+	return NewMatrix32(rows, cols)
+}
+
+// Dup (copy)
+func (a *Mat3) Dup_M() M {
+	b := *a
+	return &b
+}
+
+// Get
+func (a *Mat3) Get_M(row, col int) S {
+	return Scalar32(a[col*3+row])
+}
+
+// Set
+func (a *Mat3) Set_M(row, col int, b S) {
+	a[col*3+row] = float32(b.(Scalar32))
+}
+
+// Rows
+func (a *Mat3) Rows_M() int {
+	return 3
+}
+
+// Columns
+func (a *Mat3) Cols_M() int {
+	return 3
+}
+
+// New vector
+func (a *Mat3) NewV_M(dim int) V {
+	return NewVector32(dim)
+}
+
+// ... for the Mat4 M API
+
+// New - a Mat4 where 4 by 4, otherwise a Matrix32
+func (a *Mat4) New_M(rows, cols int) M {
+	if rows == 4 && cols == 4 {
+		return new(Mat4)
+	}
+	return NewMatrix32(rows, cols)
+}
+
+// Dup (copy)
+func (a *Mat4) Dup_M() M {
+	b := *a
+	return &b
+}
+
+// Get
+func (a *Mat4) Get_M(row, col int) S {
+	return Scalar32(a[col*4+row])
+}
+
+// Set
+func (a *Mat4) Set_M(row, col int, b S) {
+	a[col*4+row] = float32(b.(Scalar32))
+}
+
+// Rows
+func (a *Mat4) Rows_M() int {
+	return 4
+}
+
+// Columns
+func (a *Mat4) Cols_M() int {
+	return 4
+}
+
+// New vector
+func (a *Mat4) NewV_M(dim int) V {
+	return NewVector32(dim)
+}
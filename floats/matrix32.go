@@ -0,0 +1,163 @@
+// Copyright © 2015 Gerald Rosenberg.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the License.md file.
+//
+package floats
+
+import . "github.com/grosenberg/maths/algorithms"
+
+/////////////////////////////////////////////////////////////
+// Matrix type-specific API
+
+// Create a new Matrix of the given dimensions, optionally
+// initialized from the given values in row-major order
+func NewMatrix32(rows, cols int, vals ...float32) *Matrix32 {
+	m := &Matrix32{rows: rows, cols: cols}
+	m.Elem = make([]Scalar32, rows*cols)
+	for i := 0; i < len(vals) && i < len(m.Elem); i++ {
+		m.Elem[i] = Scalar32(vals[i])
+	}
+	return m
+}
+
+// Create a new n by n identity Matrix
+func NewIdentity32(n int) *Matrix32 {
+	return Identity_M(&Matrix32{}, n).(*Matrix32)
+}
+
+// Create a copy of an existing Matrix
+func (a *Matrix32) CopyMatrix32() *Matrix32 {
+	b := NewMatrix32(a.rows, a.cols)
+	copy(b.Elem, a.Elem)
+	return b
+}
+
+// Number of rows
+func (a *Matrix32) Rows() int {
+	return a.rows
+}
+
+// Number of columns
+func (a *Matrix32) Cols() int {
+	return a.cols
+}
+
+// Element at the given row and column
+func (a *Matrix32) At(row, col int) float32 {
+	return float32(a.Elem[row*a.cols+col])
+}
+
+// Set the element at the given row and column
+func (a *Matrix32) SetAt(row, col int, val float32) *Matrix32 {
+	a.Elem[row*a.cols+col] = Scalar32(val)
+	return a
+}
+
+// Matrix product of the receiver and b
+func (a *Matrix32) MatMul32(b *Matrix32) (*Matrix32, error) {
+	m, err := MatMul_M(a, b)
+	if err != nil {
+		return nil, err
+	}
+	return m.(*Matrix32), nil
+}
+
+// Transpose
+func (a *Matrix32) Transpose32() *Matrix32 {
+	return Transpose_M(a).(*Matrix32)
+}
+
+// Product of the receiver and the column vector v
+func (a *Matrix32) MulVec32(v *Vector32) (*Vector32, error) {
+	r, err := MatVec_M(a, v)
+	if err != nil {
+		return nil, err
+	}
+	return r.(*Vector32), nil
+}
+
+// Trace
+func (a *Matrix32) Trace32() (float32, error) {
+	t, err := Trace_M(a)
+	if err != nil {
+		return 0, err
+	}
+	return float32(t.(Scalar32)), nil
+}
+
+// Solve Ax = b for x, where A is the receiver, using DefaultTol32
+func (a *Matrix32) Solve32(b *Vector32) (*Vector32, error) {
+	x, err := Solve_M(a, b, DefaultTol32)
+	if err != nil {
+		return nil, err
+	}
+	return x.(*Vector32), nil
+}
+
+// Determinant
+func (a *Matrix32) Det32() (float32, error) {
+	d, err := Det_M(a)
+	if err != nil {
+		return 0, err
+	}
+	return float32(d.(Scalar32)), nil
+}
+
+// Inverse, using DefaultTol32
+func (a *Matrix32) Inverse32() (*Matrix32, error) {
+	m, err := Inverse_M(a, DefaultTol32)
+	if err != nil {
+		return nil, err
+	}
+	return m.(*Matrix32), nil
+}
+
+// QR decomposition, returning the orthogonal Q and upper triangular R
+func (a *Matrix32) QR32() (q, r *Matrix32, err error) {
+	f, err := QR_M(a)
+	if err != nil {
+		return nil, nil, err
+	}
+	return f.Q.(*Matrix32), f.R.(*Matrix32), nil
+}
+
+// Cholesky decomposition, returning the lower triangular L
+func (a *Matrix32) Cholesky32() (*Matrix32, error) {
+	l, err := Cholesky_M(a)
+	if err != nil {
+		return nil, err
+	}
+	return l.(*Matrix32), nil
+}
+
+// Least squares solution x minimizing ||Ax - b||, where A is the
+// receiver, and the residual norm ||Ax - b||, using DefaultTol32
+func (a *Matrix32) LeastSquares32(b *Vector32) (*Vector32, float32, error) {
+	x, r, err := LeastSquares_M(a, b, DefaultTol32)
+	if err != nil {
+		return nil, 0, err
+	}
+	return x.(*Vector32), float32(r.(Scalar32)), nil
+}
+
+// Eigen-decomposition of a symmetric matrix, returning the eigenvalues in
+// descending order and the corresponding eigenvectors as columns. See
+// EigenSym_M for the tolerance and iteration limit.
+func (a *Matrix32) EigenSym32(tol float64, maxIter int) (*Vector32, *Matrix32, error) {
+	vals, vecs, err := EigenSym_M(a, tol, maxIter)
+	if err != nil {
+		return nil, nil, err
+	}
+	return vals.(*Vector32), vecs.(*Matrix32), nil
+}
+
+// Singular value decomposition, returning U, the singular values in
+// descending order and V, such that A = U diag(S) V^T. See SVD_M for the
+// tolerance and iteration limit.
+func (a *Matrix32) SVD32(tol float64, maxIter int) (u *Matrix32, s *Vector32, v *Matrix32, err error) {
+	f, err := SVD_M(a, tol, maxIter)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return f.U.(*Matrix32), f.S.(*Vector32), f.V.(*Matrix32), nil
+}
@@ -0,0 +1,58 @@
+// Copyright © 2015 Gerald Rosenberg.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the License.md file.
+//
+package floats
+
+import . "github.com/grosenberg/maths/algorithms"
+
+// Type specfic composite 'value' compatible with the
+// intended generic type algorithm implemenetation.
+type Matrix32 struct {
+	Elem       []Scalar32 // elements in row-major order
+	rows, cols int
+}
+
+/////////////////////////////////////////////////////////////
+// Compile-time implementation prover
+var _ M = &Matrix32{}
+
+/////////////////////////////////////////////////////////////
+// Type-specific support for generic implementation
+
+// ... for the M API
+
+// New
+func (a *Matrix32) New_M(rows, cols int) M {
+	return NewMatrix32(rows, cols)
+}
+
+// Dup (copy)
+func (a *Matrix32) Dup_M() M {
+	return a.CopyMatrix32()
+}
+
+// Get
+func (a *Matrix32) Get_M(row, col int) S {
+	return a.Elem[row*a.cols+col]
+}
+
+// Set
+func (a *Matrix32) Set_M(row, col int, b S) {
+	a.Elem[row*a.cols+col] = b.(Scalar32)
+}
+
+// Rows
+func (a *Matrix32) Rows_M() int {
+	return a.rows
+}
+
+// Columns
+func (a *Matrix32) Cols_M() int {
+	return a.cols
+}
+
+// New vector
+func (a *Matrix32) NewV_M(dim int) V {
+	return NewVector32(dim)
+}
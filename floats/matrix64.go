@@ -0,0 +1,163 @@
+// Copyright © 2015 Gerald Rosenberg.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the License.md file.
+//
+package floats
+
+import . "github.com/grosenberg/maths/algorithms"
+
+/////////////////////////////////////////////////////////////
+// Matrix type-specific API
+
+// Create a new Matrix of the given dimensions, optionally
+// initialized from the given values in row-major order
+func NewMatrix64(rows, cols int, vals ...float64) *Matrix64 {
+	m := &Matrix64{rows: rows, cols: cols}
+	m.Elem = make([]Scalar64, rows*cols)
+	for i := 0; i < len(vals) && i < len(m.Elem); i++ {
+		m.Elem[i] = Scalar64(vals[i])
+	}
+	return m
+}
+
+// Create a new n by n identity Matrix
+func NewIdentity64(n int) *Matrix64 {
+	return Identity_M(&Matrix64{}, n).(*Matrix64)
+}
+
+// Create a copy of an existing Matrix
+func (a *Matrix64) CopyMatrix64() *Matrix64 {
+	b := NewMatrix64(a.rows, a.cols)
+	copy(b.Elem, a.Elem)
+	return b
+}
+
+// Number of rows
+func (a *Matrix64) Rows() int {
+	return a.rows
+}
+
+// Number of columns
+func (a *Matrix64) Cols() int {
+	return a.cols
+}
+
+// Element at the given row and column
+func (a *Matrix64) At(row, col int) float64 {
+	return float64(a.Elem[row*a.cols+col])
+}
+
+// Set the element at the given row and column
+func (a *Matrix64) SetAt(row, col int, val float64) *Matrix64 {
+	a.Elem[row*a.cols+col] = Scalar64(val)
+	return a
+}
+
+// Matrix product of the receiver and b
+func (a *Matrix64) MatMul64(b *Matrix64) (*Matrix64, error) {
+	m, err := MatMul_M(a, b)
+	if err != nil {
+		return nil, err
+	}
+	return m.(*Matrix64), nil
+}
+
+// Transpose
+func (a *Matrix64) Transpose64() *Matrix64 {
+	return Transpose_M(a).(*Matrix64)
+}
+
+// Product of the receiver and the column vector v
+func (a *Matrix64) MulVec64(v *Vector64) (*Vector64, error) {
+	r, err := MatVec_M(a, v)
+	if err != nil {
+		return nil, err
+	}
+	return r.(*Vector64), nil
+}
+
+// Trace
+func (a *Matrix64) Trace64() (float64, error) {
+	t, err := Trace_M(a)
+	if err != nil {
+		return 0, err
+	}
+	return float64(t.(Scalar64)), nil
+}
+
+// Solve Ax = b for x, where A is the receiver, using DefaultTol
+func (a *Matrix64) Solve64(b *Vector64) (*Vector64, error) {
+	x, err := Solve_M(a, b, DefaultTol)
+	if err != nil {
+		return nil, err
+	}
+	return x.(*Vector64), nil
+}
+
+// Determinant
+func (a *Matrix64) Det64() (float64, error) {
+	d, err := Det_M(a)
+	if err != nil {
+		return 0, err
+	}
+	return float64(d.(Scalar64)), nil
+}
+
+// Inverse, using DefaultTol
+func (a *Matrix64) Inverse64() (*Matrix64, error) {
+	m, err := Inverse_M(a, DefaultTol)
+	if err != nil {
+		return nil, err
+	}
+	return m.(*Matrix64), nil
+}
+
+// QR decomposition, returning the orthogonal Q and upper triangular R
+func (a *Matrix64) QR64() (q, r *Matrix64, err error) {
+	f, err := QR_M(a)
+	if err != nil {
+		return nil, nil, err
+	}
+	return f.Q.(*Matrix64), f.R.(*Matrix64), nil
+}
+
+// Cholesky decomposition, returning the lower triangular L
+func (a *Matrix64) Cholesky64() (*Matrix64, error) {
+	l, err := Cholesky_M(a)
+	if err != nil {
+		return nil, err
+	}
+	return l.(*Matrix64), nil
+}
+
+// Least squares solution x minimizing ||Ax - b||, where A is the
+// receiver, and the residual norm ||Ax - b||, using DefaultTol
+func (a *Matrix64) LeastSquares64(b *Vector64) (*Vector64, float64, error) {
+	x, r, err := LeastSquares_M(a, b, DefaultTol)
+	if err != nil {
+		return nil, 0, err
+	}
+	return x.(*Vector64), float64(r.(Scalar64)), nil
+}
+
+// Eigen-decomposition of a symmetric matrix, returning the eigenvalues in
+// descending order and the corresponding eigenvectors as columns. See
+// EigenSym_M for the tolerance and iteration limit.
+func (a *Matrix64) EigenSym64(tol float64, maxIter int) (*Vector64, *Matrix64, error) {
+	vals, vecs, err := EigenSym_M(a, tol, maxIter)
+	if err != nil {
+		return nil, nil, err
+	}
+	return vals.(*Vector64), vecs.(*Matrix64), nil
+}
+
+// Singular value decomposition, returning U, the singular values in
+// descending order and V, such that A = U diag(S) V^T. See SVD_M for the
+// tolerance and iteration limit.
+func (a *Matrix64) SVD64(tol float64, maxIter int) (u *Matrix64, s *Vector64, v *Matrix64, err error) {
+	f, err := SVD_M(a, tol, maxIter)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return f.U.(*Matrix64), f.S.(*Vector64), f.V.(*Matrix64), nil
+}
@@ -0,0 +1,58 @@
+// Copyright © 2015 Gerald Rosenberg.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the License.md file.
+//
+package floats
+
+import . "github.com/grosenberg/maths/algorithms"
+
+// Type specfic composite 'value' compatible with the
+// intended generic type algorithm implemenetation.
+type Matrix64 struct {
+	Elem       []Scalar64 // elements in row-major order
+	rows, cols int
+}
+
+/////////////////////////////////////////////////////////////
+// Compile-time implementation prover
+var _ M = &Matrix64{}
+
+/////////////////////////////////////////////////////////////
+// Type-specific support for generic implementation
+
+// ... for the M API
+
+// New
+func (a *Matrix64) New_M(rows, cols int) M {
+	return NewMatrix64(rows, cols)
+}
+
+// Dup (copy)
+func (a *Matrix64) Dup_M() M {
+	return a.CopyMatrix64()
+}
+
+// Get
+func (a *Matrix64) Get_M(row, col int) S {
+	return a.Elem[row*a.cols+col]
+}
+
+// Set
+func (a *Matrix64) Set_M(row, col int, b S) {
+	a.Elem[row*a.cols+col] = b.(Scalar64)
+}
+
+// Rows
+func (a *Matrix64) Rows_M() int {
+	return a.rows
+}
+
+// Columns
+func (a *Matrix64) Cols_M() int {
+	return a.cols
+}
+
+// New vector
+func (a *Matrix64) NewV_M(dim int) V {
+	return NewVector64(dim)
+}
@@ -0,0 +1,23 @@
+// Copyright © 2015 Gerald Rosenberg.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the License.md file.
+//
+package floats
+
+import (
+	"math"
+
+	. "github.com/grosenberg/maths/algorithms"
+)
+
+/////////////////////////////////////////////////////////////
+// Type-specific operations
+//
+// Computed as by RegisterFloatOp, and so supported by any real valued
+// vector type, at float64 precision.
+
+// Hypotenuse operation: sqrt(a*a + b*b), avoiding overflow and underflow
+var HypotOp = RegisterFloatOp("hypot", math.Hypot)
+
+// Arc tangent operation: the angle, in radians, of the point (b, a)
+var AtanOp = RegisterFloatOp("atan", math.Atan2)
@@ -0,0 +1,92 @@
+// Copyright © 2015 Gerald Rosenberg.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the License.md file.
+//
+package floats
+
+import . "github.com/grosenberg/maths/algorithms"
+
+/////////////////////////////////////////////////////////////
+// QuantileRegister type-specific implementations
+
+// Default t-digest compression: the digest keeps on the order of this
+// many centroids, with a quantile error of well under one percent and
+// considerably less toward the tails
+const DefaultCompression = 100
+
+// NewQuantileRegister creates a new QuantileRegister that estimates the
+// given quantile, in [0, 1], using the default compression.
+func NewQuantileRegister(q float64) *QuantileRegister {
+	return NewQuantileRegisterCompression(q, DefaultCompression)
+}
+
+// NewQuantileRegisterCompression creates a new QuantileRegister that
+// estimates the given quantile, in [0, 1], using the given compression.
+// Higher compression improves accuracy at the cost of memory. A
+// compression less than 20 is taken as 20.
+func NewQuantileRegisterCompression(q, compression float64) *QuantileRegister {
+	if compression < 20 {
+		compression = 20
+	}
+	return &QuantileRegister{q: clamp01(q), compression: compression}
+}
+
+// Accumulate adds the given values to the register values and
+// returns the current count of value contributions.
+func (reg *QuantileRegister) Accumulate(b ...float64) int {
+	reg.Lock()
+	defer reg.Unlock()
+
+	return Accumulate_R(reg, b).(int)
+}
+
+// AccumulateParallel adds the given values to the register values using the
+// given number of workers, or GOMAXPROCS workers where not positive, and
+// returns the current count of value contributions. The result is
+// deterministic for a given number of workers.
+func (reg *QuantileRegister) AccumulateParallel(b []float64, workers int) int {
+	reg.Lock()
+	defer reg.Unlock()
+
+	return AccumulateParallel_R(reg, b, workers).(int)
+}
+
+// Merge adds the contributions of the given registers, in order, to the
+// register values and returns the current count of value contributions.
+// The given registers are unchanged.
+func (reg *QuantileRegister) Merge(others ...*QuantileRegister) int {
+	o := make([]R, len(others))
+	for i, r := range others {
+		o[i] = r
+	}
+	return MergeAll_R(reg, o...).(int)
+}
+
+// Compute updates and returns the estimate of the configured quantile
+func (reg *QuantileRegister) Compute() float64 {
+	reg.Lock()
+	defer reg.Unlock()
+
+	return Compute_R(reg).(float64)
+}
+
+// Quantiles returns the estimates of the given quantiles, each in [0, 1]
+func (reg *QuantileRegister) Quantiles(qs ...float64) []float64 {
+	reg.Lock()
+	defer reg.Unlock()
+
+	reg.compress()
+	res := make([]float64, len(qs))
+	for i, q := range qs {
+		res[i] = reg.quantile(clamp01(q))
+	}
+	return res
+}
+
+// Reset clears the register values and returns the prior calculated value
+func (reg *QuantileRegister) Reset() float64 {
+	reg.Lock()
+	defer reg.Unlock()
+
+	return Reset_R(reg).(float64)
+}
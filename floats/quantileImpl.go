@@ -0,0 +1,184 @@
+// Copyright © 2015 Gerald Rosenberg.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the License.md file.
+//
+package floats
+
+import (
+	"math"
+	"sort"
+	"sync"
+
+	. "github.com/grosenberg/maths/algorithms"
+)
+
+// Type specfic composite 'value' compatible with the
+// intended generic type algorithm implemenetation.
+//
+// The quantiles are estimated using a merging t-digest (Dunning): a sorted
+// set of weighted centroids, each summarizing adjacent values, whose sizes
+// are bounded by the arcsine scale function so that centroids are small
+// near the tails and larger near the median. Values are buffered and
+// merged into the centroids in batches.
+type QuantileRegister struct {
+	sync.Mutex
+	reg         float64    // store for the final (or current) computed value
+	q           float64    // quantile computed by Update_R
+	compression float64    // scale function compression
+	centroids   []centroid // merged centroids, ordered by mean
+	buf         []centroid // unmerged values and centroids
+	count       int        // contribution counter
+	min, max    float64
+}
+
+// centroid summarizes weight values of the given mean
+type centroid struct {
+	mean, weight float64
+}
+
+/////////////////////////////////////////////////////////////
+// Compile-time implementation prover
+var _ R = &QuantileRegister{}
+
+/////////////////////////////////////////////////////////////
+// Type-specific support for generic implementation
+
+// New creates a new empty QuantileRegister of the same quantile and compression.
+func (r *QuantileRegister) New_R() R {
+	return NewQuantileRegisterCompression(r.q, r.compression)
+}
+
+// Add adds the value of the given parameter to the digest, modifying the receiver.
+func (r *QuantileRegister) Add_R(q Q) {
+	x := q.(float64)
+	if r.count == 0 || x < r.min {
+		r.min = x
+	}
+	if r.count == 0 || x > r.max {
+		r.max = x
+	}
+	r.count++
+	r.buf = append(r.buf, centroid{x, 1})
+	if len(r.buf) >= int(5*r.compression) {
+		r.compress()
+	}
+}
+
+// Merge adds the centroids of the given register to the digest, modifying
+// the receiver.
+func (r *QuantileRegister) Merge_R(o R) {
+	b := o.(*QuantileRegister)
+	if b.count == 0 {
+		return
+	}
+	if r.count == 0 || b.min < r.min {
+		r.min = b.min
+	}
+	if r.count == 0 || b.max > r.max {
+		r.max = b.max
+	}
+	r.count += b.count
+	r.buf = append(r.buf, b.centroids...)
+	r.buf = append(r.buf, b.buf...)
+	r.compress()
+}
+
+// Update computes and stores the estimate of the configured quantile,
+// modifying the receiver.
+func (r *QuantileRegister) Update_R() {
+	r.compress()
+	r.reg = r.quantile(r.q)
+}
+
+func (r *QuantileRegister) Value_R() Q {
+	return r.reg
+}
+
+func (r *QuantileRegister) Count_R() Q {
+	return r.count
+}
+
+func (r *QuantileRegister) Clear_R() {
+	r.reg = 0
+	r.centroids = nil
+	r.buf = nil
+	r.count = 0
+	r.min, r.max = 0, 0
+}
+
+// Helper functions
+
+// compress merges the buffered values and centroids into the centroids.
+func (r *QuantileRegister) compress() {
+	if len(r.buf) == 0 {
+		return
+	}
+	all := append(r.buf, r.centroids...)
+	sort.Slice(all, func(i, j int) bool { return all[i].mean < all[j].mean })
+	total := 0.0
+	for _, c := range all {
+		total += c.weight
+	}
+
+	res := make([]centroid, 0, len(r.centroids)+1)
+	cur := all[0]
+	before := 0.0
+	limit := r.limit(before / total)
+	for _, c := range all[1:] {
+		if (before+cur.weight+c.weight)/total <= limit {
+			cur.weight += c.weight
+			cur.mean += (c.mean - cur.mean) * c.weight / cur.weight
+			continue
+		}
+		res = append(res, cur)
+		before += cur.weight
+		limit = r.limit(before / total)
+		cur = c
+	}
+	r.centroids = append(res, cur)
+	r.buf = r.buf[:0]
+}
+
+// limit returns the largest quantile that a centroid starting at quantile
+// q may extend to: one unit of the scale function k(q) = d/2pi asin(2q-1).
+func (r *QuantileRegister) limit(q float64) float64 {
+	k := r.compression/(2*math.Pi)*math.Asin(2*q-1) + 1
+	if k >= r.compression/4 {
+		return 1
+	}
+	return (math.Sin(k*2*math.Pi/r.compression) + 1) / 2
+}
+
+// quantile interpolates the estimate of the given quantile between the
+// centroid means, taking each centroid's weight as centered on its mean and
+// anchoring the tails at the exact minimum and maximum.
+func (r *QuantileRegister) quantile(q float64) float64 {
+	if r.count == 0 {
+		return 0
+	}
+	cs := r.centroids
+	t := q * float64(r.count)
+	if t <= cs[0].weight/2 {
+		return r.min + (cs[0].mean-r.min)*t/(cs[0].weight/2)
+	}
+	pos := 0.0
+	for i := 0; i < len(cs)-1; i++ {
+		lo := pos + cs[i].weight/2
+		hi := pos + cs[i].weight + cs[i+1].weight/2
+		if t <= hi {
+			return cs[i].mean + (cs[i+1].mean-cs[i].mean)*(t-lo)/(hi-lo)
+		}
+		pos += cs[i].weight
+	}
+	last := cs[len(cs)-1]
+	lo := float64(r.count) - last.weight/2
+	if t >= float64(r.count) || last.weight/2 == 0 {
+		return r.max
+	}
+	return last.mean + (r.max-last.mean)*(t-lo)/(last.weight/2)
+}
+
+// clamp01 clamps the given quantile to [0, 1].
+func clamp01(q float64) float64 {
+	return math.Max(0, math.Min(1, q))
+}
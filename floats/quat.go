@@ -0,0 +1,160 @@
+// Copyright © 2015 Gerald Rosenberg.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the License.md file.
+//
+package floats
+
+import . "github.com/grosenberg/maths/algorithms"
+
+// Quaternions (x, y, z, w), where w is the scalar part. As for the fixed-size
+// vectors, quaternions are values and operations never modify the receiver.
+type (
+	Quat  Vec4
+	DQuat DVec4
+)
+
+/////////////////////////////////////////////////////////////
+// Quat
+
+// Identity Quat
+func QuatIdent() Quat {
+	return Quat{0, 0, 0, 1}
+}
+
+// Create a Quat for the rotation by angle radians about the given axis
+func QuatFromAxisAngle(axis Vec3, angle float32) Quat {
+	var q Vec4
+	QuatFromAxisAngle_V(&q, &axis, Scalar32(angle))
+	return Quat(q)
+}
+
+// Create a Quat for the rotation by the given Euler angles, in radians,
+// applied as roll about X, then pitch about Y, then yaw about Z
+func QuatFromEuler(roll, pitch, yaw float32) Quat {
+	var q Vec4
+	QuatFromEuler_V(&q, Scalar32(roll), Scalar32(pitch), Scalar32(yaw))
+	return Quat(q)
+}
+
+// Create a Quat from the upper left 3 by 3 rotation matrix of m
+func QuatFromMatrix32(m *Matrix32) (Quat, error) {
+	var q Vec4
+	if _, err := QuatFromMatrix_V(&q, m); err != nil {
+		return Quat{}, err
+	}
+	return Quat(q), nil
+}
+
+// Quaternion product, composing the rotations: rotating by a.Mul(b)
+// rotates first by b and then by a
+func (a Quat) Mul(b Quat) Quat {
+	x, y := Vec4(a), Vec4(b)
+	return Quat(*QuatMul_V(&x, &y).(*Vec4))
+}
+
+// Conjugate
+func (a Quat) Conjugate() Quat {
+	x := Vec4(a)
+	return Quat(*QuatConj_V(&x).(*Vec4))
+}
+
+// Inverse
+func (a Quat) Inverse() Quat {
+	x := Vec4(a)
+	return Quat(*QuatInverse_V(&x).(*Vec4))
+}
+
+// Unit length copy
+func (a Quat) Normalize() Quat {
+	return Quat(Vec4(a).Normalize())
+}
+
+// Length
+func (a Quat) Length() float32 {
+	return Vec4(a).Length()
+}
+
+// Rotate the vector v, where the receiver is a unit quaternion
+func (a Quat) Rotate(v Vec3) Vec3 {
+	x := Vec4(a)
+	return *QuatRotate_V(&x, &v).(*Vec3)
+}
+
+// Spherical linear interpolation along the shortest arc
+func (a Quat) Slerp(b Quat, t float32) Quat {
+	x, y := Vec4(a), Vec4(b)
+	return Quat(*SLerpShortest_V(&x, &y, Scalar32(t)).(*Vec4))
+}
+
+/////////////////////////////////////////////////////////////
+// DQuat
+
+// Identity DQuat
+func DQuatIdent() DQuat {
+	return DQuat{0, 0, 0, 1}
+}
+
+// Create a DQuat for the rotation by angle radians about the given axis
+func DQuatFromAxisAngle(axis DVec3, angle float64) DQuat {
+	var q DVec4
+	QuatFromAxisAngle_V(&q, &axis, Scalar64(angle))
+	return DQuat(q)
+}
+
+// Create a DQuat for the rotation by the given Euler angles, in radians,
+// applied as roll about X, then pitch about Y, then yaw about Z
+func DQuatFromEuler(roll, pitch, yaw float64) DQuat {
+	var q DVec4
+	QuatFromEuler_V(&q, Scalar64(roll), Scalar64(pitch), Scalar64(yaw))
+	return DQuat(q)
+}
+
+// Create a DQuat from the upper left 3 by 3 rotation matrix of m
+func DQuatFromMatrix64(m *Matrix64) (DQuat, error) {
+	var q DVec4
+	if _, err := QuatFromMatrix_V(&q, m); err != nil {
+		return DQuat{}, err
+	}
+	return DQuat(q), nil
+}
+
+// Quaternion product, composing the rotations: rotating by a.Mul(b)
+// rotates first by b and then by a
+func (a DQuat) Mul(b DQuat) DQuat {
+	x, y := DVec4(a), DVec4(b)
+	return DQuat(*QuatMul_V(&x, &y).(*DVec4))
+}
+
+// Conjugate
+func (a DQuat) Conjugate() DQuat {
+	x := DVec4(a)
+	return DQuat(*QuatConj_V(&x).(*DVec4))
+}
+
+// Inverse
+func (a DQuat) Inverse() DQuat {
+	x := DVec4(a)
+	return DQuat(*QuatInverse_V(&x).(*DVec4))
+}
+
+// Unit length copy
+func (a DQuat) Normalize() DQuat {
+	return DQuat(DVec4(a).Normalize())
+}
+
+// Length
+func (a DQuat) Length() float64 {
+	return DVec4(a).Length()
+}
+
+// Rotate the vector v, where the receiver is a unit quaternion
+func (a DQuat) Rotate(v DVec3) DVec3 {
+	x := DVec4(a)
+	return *QuatRotate_V(&x, &v).(*DVec3)
+}
+
+// Spherical linear interpolation along the shortest arc
+func (a DQuat) Slerp(b DQuat, t float64) DQuat {
+	x, y := DVec4(a), DVec4(b)
+	return DQuat(*SLerpShortest_V(&x, &y, Scalar64(t)).(*DVec4))
+}
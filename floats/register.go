@@ -16,13 +16,35 @@ func NewRegister() *Register {
 
 // Accumulate adds the given values to the register values and
 // returns the current count of value contributions.
-func (reg *Register) Accumulate(b ...int) int {
+func (reg *Register) Accumulate(b ...float64) int {
 	reg.Lock()
 	defer reg.Unlock()
 
 	return Accumulate_R(reg, b).(int)
 }
 
+// AccumulateParallel adds the given values to the register values using the
+// given number of workers, or GOMAXPROCS workers where not positive, and
+// returns the current count of value contributions. The result is
+// deterministic for a given number of workers.
+func (reg *Register) AccumulateParallel(b []float64, workers int) int {
+	reg.Lock()
+	defer reg.Unlock()
+
+	return AccumulateParallel_R(reg, b, workers).(int)
+}
+
+// Merge adds the contributions of the given registers, in order, to the
+// register values and returns the current count of value contributions.
+// The given registers are unchanged.
+func (reg *Register) Merge(others ...*Register) int {
+	o := make([]R, len(others))
+	for i, r := range others {
+		o[i] = r
+	}
+	return MergeAll_R(reg, o...).(int)
+}
+
 // Compute updates and returns the calculated value
 func (reg *Register) Compute() float64 {
 	reg.Lock()
@@ -38,3 +60,10 @@ func (reg *Register) Reset() float64 {
 
 	return Reset_R(reg).(float64)
 }
+
+// Average returns the average of the given values.
+func Average(vals ...float64) float64 {
+	reg := NewRegister()
+	reg.Accumulate(vals...)
+	return reg.Compute()
+}
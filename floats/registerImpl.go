@@ -16,7 +16,7 @@ type Register struct {
 	sync.Mutex
 	reg   float64 // store for the final (or current) computed value
 	accum float64 // accumulator for interim values
-	count int64   // contribution counter
+	count int     // contribution counter
 }
 
 /////////////////////////////////////////////////////////////
@@ -32,9 +32,26 @@ func (r *Register) Add_R(q Q) {
 	r.count++
 }
 
+// New creates a new empty Register.
+func (r *Register) New_R() R {
+	return NewRegister()
+}
+
+// Merge adds the contributions of the given register to the receiver,
+// modifying the receiver.
+func (r *Register) Merge_R(o R) {
+	b := o.(*Register)
+	r.accum += b.accum
+	r.count += b.count
+}
+
 // Update computes and stores the current average based on the value of the
 // given parameter, modifying the receiver.
 func (r *Register) Update_R() {
+	if r.count == 0 {
+		r.reg = 0
+		return
+	}
 	r.reg = r.accum / float64(r.count)
 }
 
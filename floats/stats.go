@@ -0,0 +1,83 @@
+// Copyright © 2015 Gerald Rosenberg.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the License.md file.
+//
+package floats
+
+import . "github.com/grosenberg/maths/algorithms"
+
+/////////////////////////////////////////////////////////////
+// StatsRegister type-specific implementations
+
+// Summary statistics of the values contributed to a StatsRegister
+type Stats struct {
+	Count          int
+	Mean           float64
+	Variance       float64 // population variance
+	SampleVariance float64 // unbiased sample variance; zero for fewer than 2 values
+	StdDev         float64 // population standard deviation
+	SampleStdDev   float64 // sample standard deviation
+	Min            float64
+	Max            float64
+	Skewness       float64 // population skewness
+	Kurtosis       float64 // population excess kurtosis
+}
+
+// NewStatsRegister creates a new StatsRegister.
+func NewStatsRegister() *StatsRegister {
+	return &StatsRegister{}
+}
+
+// Accumulate adds the given values to the register values and
+// returns the current count of value contributions.
+func (reg *StatsRegister) Accumulate(b ...float64) int {
+	reg.Lock()
+	defer reg.Unlock()
+
+	return Accumulate_R(reg, b).(int)
+}
+
+// AccumulateParallel adds the given values to the register values using the
+// given number of workers, or GOMAXPROCS workers where not positive, and
+// returns the current count of value contributions. The result is
+// deterministic for a given number of workers.
+func (reg *StatsRegister) AccumulateParallel(b []float64, workers int) int {
+	reg.Lock()
+	defer reg.Unlock()
+
+	return AccumulateParallel_R(reg, b, workers).(int)
+}
+
+// Merge adds the contributions of the given registers, in order, to the
+// register values and returns the current count of value contributions.
+// The given registers are unchanged.
+func (reg *StatsRegister) Merge(others ...*StatsRegister) int {
+	o := make([]R, len(others))
+	for i, r := range others {
+		o[i] = r
+	}
+	return MergeAll_R(reg, o...).(int)
+}
+
+// Compute updates and returns the calculated statistics
+func (reg *StatsRegister) Compute() Stats {
+	reg.Lock()
+	defer reg.Unlock()
+
+	return Compute_R(reg).(Stats)
+}
+
+// Reset clears the register values and returns the prior calculated statistics
+func (reg *StatsRegister) Reset() Stats {
+	reg.Lock()
+	defer reg.Unlock()
+
+	return Reset_R(reg).(Stats)
+}
+
+// Statistics returns the summary statistics of the given values.
+func Statistics(vals ...float64) Stats {
+	reg := NewStatsRegister()
+	reg.Accumulate(vals...)
+	return reg.Compute()
+}
@@ -0,0 +1,138 @@
+// Copyright © 2015 Gerald Rosenberg.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the License.md file.
+//
+package floats
+
+import (
+	"math"
+	"sync"
+
+	. "github.com/grosenberg/maths/algorithms"
+)
+
+// Type specfic composite 'value' compatible with the
+// intended generic type algorithm implemenetation.
+//
+// The moments are accumulated using Welford's online algorithm, extended
+// to the third and fourth central moments, which avoids the catastrophic
+// cancellation of the naive sum of squares.
+type StatsRegister struct {
+	sync.Mutex
+	reg      Stats   // store for the final (or current) computed value
+	count    int     // contribution counter
+	mean     float64 // running mean
+	m2       float64 // running sums of powers of differences from the mean
+	m3       float64
+	m4       float64
+	min, max float64
+}
+
+/////////////////////////////////////////////////////////////
+// Compile-time implementation prover
+var _ R = &StatsRegister{}
+
+/////////////////////////////////////////////////////////////
+// Type-specific support for generic implementation
+
+// New creates a new empty StatsRegister.
+func (r *StatsRegister) New_R() R {
+	return NewStatsRegister()
+}
+
+// Add adds the value of the given parameter to the moments of the receiver,
+// modifying the receiver.
+func (r *StatsRegister) Add_R(q Q) {
+	x := q.(float64)
+	n1 := float64(r.count)
+	r.count++
+	n := float64(r.count)
+
+	delta := x - r.mean
+	dn := delta / n
+	dn2 := dn * dn
+	term := delta * dn * n1
+	r.mean += dn
+	r.m4 += term*dn2*(n*n-3*n+3) + 6*dn2*r.m2 - 4*dn*r.m3
+	r.m3 += term*dn*(n-2) - 3*dn*r.m2
+	r.m2 += term
+
+	if r.count == 1 || x < r.min {
+		r.min = x
+	}
+	if r.count == 1 || x > r.max {
+		r.max = x
+	}
+}
+
+// Merge adds the contributions of the given register to the moments of the
+// receiver, modifying the receiver. The moments are combined using the
+// pairwise formulas of Chan et al., extended to the third and fourth
+// central moments by Pébay.
+func (r *StatsRegister) Merge_R(o R) {
+	b := o.(*StatsRegister)
+	if b.count == 0 {
+		return
+	}
+	if r.count == 0 {
+		r.count, r.mean, r.m2, r.m3, r.m4 = b.count, b.mean, b.m2, b.m3, b.m4
+		r.min, r.max = b.min, b.max
+		return
+	}
+	na, nb := float64(r.count), float64(b.count)
+	n := na + nb
+	delta := b.mean - r.mean
+	d2 := delta * delta
+
+	m2 := r.m2 + b.m2 + d2*na*nb/n
+	m3 := r.m3 + b.m3 + d2*delta*na*nb*(na-nb)/(n*n) +
+		3*delta*(na*b.m2-nb*r.m2)/n
+	m4 := r.m4 + b.m4 + d2*d2*na*nb*(na*na-na*nb+nb*nb)/(n*n*n) +
+		6*d2*(na*na*b.m2+nb*nb*r.m2)/(n*n) + 4*delta*(na*b.m3-nb*r.m3)/n
+
+	r.count += b.count
+	r.mean += delta * nb / n
+	r.m2, r.m3, r.m4 = m2, m3, m4
+	if b.min < r.min {
+		r.min = b.min
+	}
+	if b.max > r.max {
+		r.max = b.max
+	}
+}
+
+// Update computes and stores the current statistics, modifying the receiver.
+func (r *StatsRegister) Update_R() {
+	r.reg = Stats{Count: r.count}
+	if r.count == 0 {
+		return
+	}
+	n := float64(r.count)
+	r.reg.Mean = r.mean
+	r.reg.Min, r.reg.Max = r.min, r.max
+	r.reg.Variance = r.m2 / n
+	r.reg.StdDev = math.Sqrt(r.reg.Variance)
+	if r.count > 1 {
+		r.reg.SampleVariance = r.m2 / (n - 1)
+		r.reg.SampleStdDev = math.Sqrt(r.reg.SampleVariance)
+	}
+	if r.m2 > 0 {
+		r.reg.Skewness = math.Sqrt(n) * r.m3 / math.Pow(r.m2, 1.5)
+		r.reg.Kurtosis = n*r.m4/(r.m2*r.m2) - 3
+	}
+}
+
+func (r *StatsRegister) Value_R() Q {
+	return r.reg
+}
+
+func (r *StatsRegister) Count_R() Q {
+	return r.count
+}
+
+func (r *StatsRegister) Clear_R() {
+	r.reg = Stats{}
+	r.count = 0
+	r.mean, r.m2, r.m3, r.m4 = 0, 0, 0, 0
+	r.min, r.max = 0, 0
+}
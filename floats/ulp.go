@@ -0,0 +1,57 @@
+// Copyright © 2015 Gerald Rosenberg.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the License.md file.
+//
+package floats
+
+import "math"
+
+// Number of representable float32 values between a and b, the distance in
+// units in the last place. Zeros of either sign are at distance zero; the
+// distance involving a NaN is the maximum distance.
+func ULPDistance32(a, b float32) uint32 {
+	if a != a || b != b {
+		return math.MaxUint32
+	}
+	x, y := ordered32(a), ordered32(b)
+	if x > y {
+		return x - y
+	}
+	return y - x
+}
+
+// Number of representable float64 values between a and b, the distance in
+// units in the last place. Zeros of either sign are at distance zero; the
+// distance involving a NaN is the maximum distance.
+func ULPDistance64(a, b float64) uint64 {
+	if a != a || b != b {
+		return math.MaxUint64
+	}
+	x, y := ordered64(a), ordered64(b)
+	if x > y {
+		return x - y
+	}
+	return y - x
+}
+
+// ordered32 maps the float32 bit pattern to an unsigned integer with the
+// same ordering as the float value, mapping both zeros to the same value.
+func ordered32(f float32) uint32 {
+	const sign = 1 << 31
+	u := math.Float32bits(f)
+	if u&sign != 0 {
+		return sign - u&^sign
+	}
+	return sign + u
+}
+
+// ordered64 maps the float64 bit pattern to an unsigned integer with the
+// same ordering as the float value, mapping both zeros to the same value.
+func ordered64(f float64) uint64 {
+	const sign = 1 << 63
+	u := math.Float64bits(f)
+	if u&sign != 0 {
+		return sign - u&^sign
+	}
+	return sign + u
+}
@@ -0,0 +1,641 @@
+// Copyright © 2015 Gerald Rosenberg.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the License.md file.
+//
+package floats
+
+import . "github.com/grosenberg/maths/algorithms"
+
+/////////////////////////////////////////////////////////////
+// Fixed-size vector type-specific API
+//
+// The fixed-size vectors are values: operations return a new value and
+// never modify the receiver.
+
+/////////////////////////////////////////////////////////////
+// Vec2
+
+// X element
+func (a Vec2) X() float32 {
+	return a[X]
+}
+
+// Y element
+func (a Vec2) Y() float32 {
+	return a[Y]
+}
+
+// YX swizzle
+func (a Vec2) YX() Vec2 {
+	return Vec2{a[Y], a[X]}
+}
+
+// Add
+func (a Vec2) Add(b Vec2) Vec2 {
+	return *Modify_V(&a, AddOp, &b).(*Vec2)
+}
+
+// Subtract
+func (a Vec2) Sub(b Vec2) Vec2 {
+	return *Modify_V(&a, SubOp, &b).(*Vec2)
+}
+
+// Multiply by a scalar value
+func (a Vec2) Scale(val float32) Vec2 {
+	return *ModifyScalar_V(&a, MulOp, Scalar32(val)).(*Vec2)
+}
+
+// Negate
+func (a Vec2) Negate() Vec2 {
+	return *Negate_V(&a).(*Vec2)
+}
+
+// Dot product
+func (a Vec2) Dot(b Vec2) float32 {
+	return float32(Dot_V(&a, &b).(Scalar32))
+}
+
+// Euclidean length
+func (a Vec2) Length() float32 {
+	return float32(Norm_V(&a, L2).(Scalar32))
+}
+
+// Unit length copy; a zero length vector is returned unchanged
+func (a Vec2) Normalize() Vec2 {
+	l := a.Length()
+	if l == 0 {
+		return a
+	}
+	return a.Scale(1 / l)
+}
+
+// Linear interpolation
+func (a Vec2) Lerp(b Vec2, t float32) Vec2 {
+	return *Lerp_V(&a, &b, Scalar32(t)).(*Vec2)
+}
+
+// Spherical Linear interpolation
+func (a Vec2) SLerp(b Vec2, t float32) Vec2 {
+	return *SLerp_V(&a, &b, Scalar32(t)).(*Vec2)
+}
+
+// Convert to a Vector32
+func (a Vec2) Vector32() *Vector32 {
+	v := NewVector32(2)
+	for i := range a {
+		v.Elem[i] = Scalar32(a[i])
+	}
+	return v
+}
+
+// Convert from a Vector32; missing elements are zero and extra elements are ignored
+func Vec2FromVector32(v *Vector32) Vec2 {
+	var a Vec2
+	for i := 0; i < len(a) && i < len(v.Elem); i++ {
+		a[i] = float32(v.Elem[i])
+	}
+	return a
+}
+
+/////////////////////////////////////////////////////////////
+// Vec3
+
+// X element
+func (a Vec3) X() float32 {
+	return a[X]
+}
+
+// Y element
+func (a Vec3) Y() float32 {
+	return a[Y]
+}
+
+// Z element
+func (a Vec3) Z() float32 {
+	return a[Z]
+}
+
+// XY swizzle
+func (a Vec3) XY() Vec2 {
+	return Vec2{a[X], a[Y]}
+}
+
+// XZ swizzle
+func (a Vec3) XZ() Vec2 {
+	return Vec2{a[X], a[Z]}
+}
+
+// YZ swizzle
+func (a Vec3) YZ() Vec2 {
+	return Vec2{a[Y], a[Z]}
+}
+
+// ZYX swizzle
+func (a Vec3) ZYX() Vec3 {
+	return Vec3{a[Z], a[Y], a[X]}
+}
+
+// XYZ0 swizzle
+func (a Vec3) XYZ0() Vec4 {
+	return Vec4{a[X], a[Y], a[Z], 0}
+}
+
+// XYZ1 swizzle
+func (a Vec3) XYZ1() Vec4 {
+	return Vec4{a[X], a[Y], a[Z], 1}
+}
+
+// Cross product
+func (a Vec3) Cross(b Vec3) Vec3 {
+	return Vec3{
+		a[Y]*b[Z] - a[Z]*b[Y],
+		a[Z]*b[X] - a[X]*b[Z],
+		a[X]*b[Y] - a[Y]*b[X],
+	}
+}
+
+// Add
+func (a Vec3) Add(b Vec3) Vec3 {
+	return *Modify_V(&a, AddOp, &b).(*Vec3)
+}
+
+// Subtract
+func (a Vec3) Sub(b Vec3) Vec3 {
+	return *Modify_V(&a, SubOp, &b).(*Vec3)
+}
+
+// Multiply by a scalar value
+func (a Vec3) Scale(val float32) Vec3 {
+	return *ModifyScalar_V(&a, MulOp, Scalar32(val)).(*Vec3)
+}
+
+// Negate
+func (a Vec3) Negate() Vec3 {
+	return *Negate_V(&a).(*Vec3)
+}
+
+// Dot product
+func (a Vec3) Dot(b Vec3) float32 {
+	return float32(Dot_V(&a, &b).(Scalar32))
+}
+
+// Euclidean length
+func (a Vec3) Length() float32 {
+	return float32(Norm_V(&a, L2).(Scalar32))
+}
+
+// Unit length copy; a zero length vector is returned unchanged
+func (a Vec3) Normalize() Vec3 {
+	l := a.Length()
+	if l == 0 {
+		return a
+	}
+	return a.Scale(1 / l)
+}
+
+// Linear interpolation
+func (a Vec3) Lerp(b Vec3, t float32) Vec3 {
+	return *Lerp_V(&a, &b, Scalar32(t)).(*Vec3)
+}
+
+// Spherical Linear interpolation
+func (a Vec3) SLerp(b Vec3, t float32) Vec3 {
+	return *SLerp_V(&a, &b, Scalar32(t)).(*Vec3)
+}
+
+// Convert to a Vector32
+func (a Vec3) Vector32() *Vector32 {
+	v := NewVector32(3)
+	for i := range a {
+		v.Elem[i] = Scalar32(a[i])
+	}
+	return v
+}
+
+// Convert from a Vector32; missing elements are zero and extra elements are ignored
+func Vec3FromVector32(v *Vector32) Vec3 {
+	var a Vec3
+	for i := 0; i < len(a) && i < len(v.Elem); i++ {
+		a[i] = float32(v.Elem[i])
+	}
+	return a
+}
+
+/////////////////////////////////////////////////////////////
+// Vec4
+
+// X element
+func (a Vec4) X() float32 {
+	return a[X]
+}
+
+// Y element
+func (a Vec4) Y() float32 {
+	return a[Y]
+}
+
+// Z element
+func (a Vec4) Z() float32 {
+	return a[Z]
+}
+
+// W element
+func (a Vec4) W() float32 {
+	return a[W]
+}
+
+// XY swizzle
+func (a Vec4) XY() Vec2 {
+	return Vec2{a[X], a[Y]}
+}
+
+// XYZ swizzle
+func (a Vec4) XYZ() Vec3 {
+	return Vec3{a[X], a[Y], a[Z]}
+}
+
+// WZYX swizzle
+func (a Vec4) WZYX() Vec4 {
+	return Vec4{a[W], a[Z], a[Y], a[X]}
+}
+
+// Add
+func (a Vec4) Add(b Vec4) Vec4 {
+	return *Modify_V(&a, AddOp, &b).(*Vec4)
+}
+
+// Subtract
+func (a Vec4) Sub(b Vec4) Vec4 {
+	return *Modify_V(&a, SubOp, &b).(*Vec4)
+}
+
+// Multiply by a scalar value
+func (a Vec4) Scale(val float32) Vec4 {
+	return *ModifyScalar_V(&a, MulOp, Scalar32(val)).(*Vec4)
+}
+
+// Negate
+func (a Vec4) Negate() Vec4 {
+	return *Negate_V(&a).(*Vec4)
+}
+
+// Dot product
+func (a Vec4) Dot(b Vec4) float32 {
+	return float32(Dot_V(&a, &b).(Scalar32))
+}
+
+// Euclidean length
+func (a Vec4) Length() float32 {
+	return float32(Norm_V(&a, L2).(Scalar32))
+}
+
+// Unit length copy; a zero length vector is returned unchanged
+func (a Vec4) Normalize() Vec4 {
+	l := a.Length()
+	if l == 0 {
+		return a
+	}
+	return a.Scale(1 / l)
+}
+
+// Linear interpolation
+func (a Vec4) Lerp(b Vec4, t float32) Vec4 {
+	return *Lerp_V(&a, &b, Scalar32(t)).(*Vec4)
+}
+
+// Spherical Linear interpolation
+func (a Vec4) SLerp(b Vec4, t float32) Vec4 {
+	return *SLerp_V(&a, &b, Scalar32(t)).(*Vec4)
+}
+
+// Convert to a Vector32
+func (a Vec4) Vector32() *Vector32 {
+	v := NewVector32(4)
+	for i := range a {
+		v.Elem[i] = Scalar32(a[i])
+	}
+	return v
+}
+
+// Convert from a Vector32; missing elements are zero and extra elements are ignored
+func Vec4FromVector32(v *Vector32) Vec4 {
+	var a Vec4
+	for i := 0; i < len(a) && i < len(v.Elem); i++ {
+		a[i] = float32(v.Elem[i])
+	}
+	return a
+}
+
+/////////////////////////////////////////////////////////////
+// DVec2
+
+// X element
+func (a DVec2) X() float64 {
+	return a[X]
+}
+
+// Y element
+func (a DVec2) Y() float64 {
+	return a[Y]
+}
+
+// YX swizzle
+func (a DVec2) YX() DVec2 {
+	return DVec2{a[Y], a[X]}
+}
+
+// Add
+func (a DVec2) Add(b DVec2) DVec2 {
+	return *Modify_V(&a, AddOp, &b).(*DVec2)
+}
+
+// Subtract
+func (a DVec2) Sub(b DVec2) DVec2 {
+	return *Modify_V(&a, SubOp, &b).(*DVec2)
+}
+
+// Multiply by a scalar value
+func (a DVec2) Scale(val float64) DVec2 {
+	return *ModifyScalar_V(&a, MulOp, Scalar64(val)).(*DVec2)
+}
+
+// Negate
+func (a DVec2) Negate() DVec2 {
+	return *Negate_V(&a).(*DVec2)
+}
+
+// Dot product
+func (a DVec2) Dot(b DVec2) float64 {
+	return float64(Dot_V(&a, &b).(Scalar64))
+}
+
+// Euclidean length
+func (a DVec2) Length() float64 {
+	return float64(Norm_V(&a, L2).(Scalar64))
+}
+
+// Unit length copy; a zero length vector is returned unchanged
+func (a DVec2) Normalize() DVec2 {
+	l := a.Length()
+	if l == 0 {
+		return a
+	}
+	return a.Scale(1 / l)
+}
+
+// Linear interpolation
+func (a DVec2) Lerp(b DVec2, t float64) DVec2 {
+	return *Lerp_V(&a, &b, Scalar64(t)).(*DVec2)
+}
+
+// Spherical Linear interpolation
+func (a DVec2) SLerp(b DVec2, t float64) DVec2 {
+	return *SLerp_V(&a, &b, Scalar64(t)).(*DVec2)
+}
+
+// Convert to a Vector64
+func (a DVec2) Vector64() *Vector64 {
+	v := NewVector64(2)
+	for i := range a {
+		v.Elem[i] = Scalar64(a[i])
+	}
+	return v
+}
+
+// Convert from a Vector64; missing elements are zero and extra elements are ignored
+func DVec2FromVector64(v *Vector64) DVec2 {
+	var a DVec2
+	for i := 0; i < len(a) && i < len(v.Elem); i++ {
+		a[i] = float64(v.Elem[i])
+	}
+	return a
+}
+
+/////////////////////////////////////////////////////////////
+// DVec3
+
+// X element
+func (a DVec3) X() float64 {
+	return a[X]
+}
+
+// Y element
+func (a DVec3) Y() float64 {
+	return a[Y]
+}
+
+// Z element
+func (a DVec3) Z() float64 {
+	return a[Z]
+}
+
+// XY swizzle
+func (a DVec3) XY() DVec2 {
+	return DVec2{a[X], a[Y]}
+}
+
+// XZ swizzle
+func (a DVec3) XZ() DVec2 {
+	return DVec2{a[X], a[Z]}
+}
+
+// YZ swizzle
+func (a DVec3) YZ() DVec2 {
+	return DVec2{a[Y], a[Z]}
+}
+
+// ZYX swizzle
+func (a DVec3) ZYX() DVec3 {
+	return DVec3{a[Z], a[Y], a[X]}
+}
+
+// XYZ0 swizzle
+func (a DVec3) XYZ0() DVec4 {
+	return DVec4{a[X], a[Y], a[Z], 0}
+}
+
+// XYZ1 swizzle
+func (a DVec3) XYZ1() DVec4 {
+	return DVec4{a[X], a[Y], a[Z], 1}
+}
+
+// Cross product
+func (a DVec3) Cross(b DVec3) DVec3 {
+	return DVec3{
+		a[Y]*b[Z] - a[Z]*b[Y],
+		a[Z]*b[X] - a[X]*b[Z],
+		a[X]*b[Y] - a[Y]*b[X],
+	}
+}
+
+// Add
+func (a DVec3) Add(b DVec3) DVec3 {
+	return *Modify_V(&a, AddOp, &b).(*DVec3)
+}
+
+// Subtract
+func (a DVec3) Sub(b DVec3) DVec3 {
+	return *Modify_V(&a, SubOp, &b).(*DVec3)
+}
+
+// Multiply by a scalar value
+func (a DVec3) Scale(val float64) DVec3 {
+	return *ModifyScalar_V(&a, MulOp, Scalar64(val)).(*DVec3)
+}
+
+// Negate
+func (a DVec3) Negate() DVec3 {
+	return *Negate_V(&a).(*DVec3)
+}
+
+// Dot product
+func (a DVec3) Dot(b DVec3) float64 {
+	return float64(Dot_V(&a, &b).(Scalar64))
+}
+
+// Euclidean length
+func (a DVec3) Length() float64 {
+	return float64(Norm_V(&a, L2).(Scalar64))
+}
+
+// Unit length copy; a zero length vector is returned unchanged
+func (a DVec3) Normalize() DVec3 {
+	l := a.Length()
+	if l == 0 {
+		return a
+	}
+	return a.Scale(1 / l)
+}
+
+// Linear interpolation
+func (a DVec3) Lerp(b DVec3, t float64) DVec3 {
+	return *Lerp_V(&a, &b, Scalar64(t)).(*DVec3)
+}
+
+// Spherical Linear interpolation
+func (a DVec3) SLerp(b DVec3, t float64) DVec3 {
+	return *SLerp_V(&a, &b, Scalar64(t)).(*DVec3)
+}
+
+// Convert to a Vector64
+func (a DVec3) Vector64() *Vector64 {
+	v := NewVector64(3)
+	for i := range a {
+		v.Elem[i] = Scalar64(a[i])
+	}
+	return v
+}
+
+// Convert from a Vector64; missing elements are zero and extra elements are ignored
+func DVec3FromVector64(v *Vector64) DVec3 {
+	var a DVec3
+	for i := 0; i < len(a) && i < len(v.Elem); i++ {
+		a[i] = float64(v.Elem[i])
+	}
+	return a
+}
+
+/////////////////////////////////////////////////////////////
+// DVec4
+
+// X element
+func (a DVec4) X() float64 {
+	return a[X]
+}
+
+// Y element
+func (a DVec4) Y() float64 {
+	return a[Y]
+}
+
+// Z element
+func (a DVec4) Z() float64 {
+	return a[Z]
+}
+
+// W element
+func (a DVec4) W() float64 {
+	return a[W]
+}
+
+// XY swizzle
+func (a DVec4) XY() DVec2 {
+	return DVec2{a[X], a[Y]}
+}
+
+// XYZ swizzle
+func (a DVec4) XYZ() DVec3 {
+	return DVec3{a[X], a[Y], a[Z]}
+}
+
+// WZYX swizzle
+func (a DVec4) WZYX() DVec4 {
+	return DVec4{a[W], a[Z], a[Y], a[X]}
+}
+
+// Add
+func (a DVec4) Add(b DVec4) DVec4 {
+	return *Modify_V(&a, AddOp, &b).(*DVec4)
+}
+
+// Subtract
+func (a DVec4) Sub(b DVec4) DVec4 {
+	return *Modify_V(&a, SubOp, &b).(*DVec4)
+}
+
+// Multiply by a scalar value
+func (a DVec4) Scale(val float64) DVec4 {
+	return *ModifyScalar_V(&a, MulOp, Scalar64(val)).(*DVec4)
+}
+
+// Negate
+func (a DVec4) Negate() DVec4 {
+	return *Negate_V(&a).(*DVec4)
+}
+
+// Dot product
+func (a DVec4) Dot(b DVec4) float64 {
+	return float64(Dot_V(&a, &b).(Scalar64))
+}
+
+// Euclidean length
+func (a DVec4) Length() float64 {
+	return float64(Norm_V(&a, L2).(Scalar64))
+}
+
+// Unit length copy; a zero length vector is returned unchanged
+func (a DVec4) Normalize() DVec4 {
+	l := a.Length()
+	if l == 0 {
+		return a
+	}
+	return a.Scale(1 / l)
+}
+
+// Linear interpolation
+func (a DVec4) Lerp(b DVec4, t float64) DVec4 {
+	return *Lerp_V(&a, &b, Scalar64(t)).(*DVec4)
+}
+
+// Spherical Linear interpolation
+func (a DVec4) SLerp(b DVec4, t float64) DVec4 {
+	return *SLerp_V(&a, &b, Scalar64(t)).(*DVec4)
+}
+
+// Convert to a Vector64
+func (a DVec4) Vector64() *Vector64 {
+	v := NewVector64(4)
+	for i := range a {
+		v.Elem[i] = Scalar64(a[i])
+	}
+	return v
+}
+
+// Convert from a Vector64; missing elements are zero and extra elements are ignored
+func DVec4FromVector64(v *Vector64) DVec4 {
+	var a DVec4
+	for i := 0; i < len(a) && i < len(v.Elem); i++ {
+		a[i] = float64(v.Elem[i])
+	}
+	return a
+}
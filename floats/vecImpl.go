@@ -0,0 +1,574 @@
+// Copyright © 2015 Gerald Rosenberg.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the License.md file.
+//
+package floats
+
+import . "github.com/grosenberg/maths/algorithms"
+
+// Fixed-size value vectors. The array types do not synchronize: their V API
+// locks are no-ops, so callers sharing a vector between goroutines must
+// synchronize access themselves.
+type (
+	Vec2 [2]float32
+	Vec3 [3]float32
+	Vec4 [4]float32
+
+	DVec2 [2]float64
+	DVec3 [3]float64
+	DVec4 [4]float64
+)
+
+/////////////////////////////////////////////////////////////
+// Compile-time implementation prover
+var (
+	_ V = &Vec2{}
+	_ V = &Vec3{}
+	_ V = &Vec4{}
+	_ V = &DVec2{}
+	_ V = &DVec3{}
+	_ V = &DVec4{}
+)
+
+/////////////////////////////////////////////////////////////
+// Type-specific support for generic implementation
+
+// ... for the Vec2 V API
+
+// Lock - no-op
+func (a *Vec2) Lock() {}
+
+// Unlock - no-op
+func (a *Vec2) Unlock() {}
+
+// New
+func (a *Vec2) New_V() V {
+	return new(Vec2)
+}
+
+// Dup (copy)
+func (a *Vec2) Dup_V() V {
+	b := *a
+	return &b
+}
+
+// Get
+func (a *Vec2) Get_V(pos int) S {
+	return Scalar32(a[pos])
+}
+
+// Set
+func (a *Vec2) Set_V(pos int, b S) {
+	a[pos] = float32(b.(Scalar32))
+}
+
+// Add
+func (a *Vec2) Add_V(pos int, b V) {
+	a[pos] += b.(*Vec2)[pos]
+}
+
+// Subtract
+func (a *Vec2) Sub_V(pos int, b V) {
+	a[pos] -= b.(*Vec2)[pos]
+}
+
+// Multiply
+func (a *Vec2) Mul_V(pos int, b V) {
+	a[pos] *= b.(*Vec2)[pos]
+}
+
+// Divide
+func (a *Vec2) Div_V(pos int, b V) {
+	a[pos] /= b.(*Vec2)[pos]
+}
+
+// Add Scalar
+func (a *Vec2) AddSc_V(pos int, b S) {
+	a[pos] += float32(b.(Scalar32))
+}
+
+// Subtract Scalar
+func (a *Vec2) SubSc_V(pos int, b S) {
+	a[pos] -= float32(b.(Scalar32))
+}
+
+// Multiply Scalar
+func (a *Vec2) MulSc_V(pos int, b S) {
+	a[pos] *= float32(b.(Scalar32))
+}
+
+// Divide Scalar
+func (a *Vec2) DivSc_V(pos int, b S) {
+	a[pos] /= float32(b.(Scalar32))
+}
+
+// Negate a vector element
+func (a *Vec2) Neg_V(pos int) {
+	a[pos] = -a[pos]
+}
+
+// Vector length
+func (a *Vec2) Len_V() int {
+	return 2
+}
+
+// Minimum relative vector length
+func (a *Vec2) LenMin_V(b V) int {
+	_ = b.(*Vec2)
+	return 2
+}
+
+// Zero valued element
+func (a *Vec2) Zero_V() S {
+	return Scalar32(0)
+}
+
+// ... for the Vec3 V API
+
+// Lock - no-op
+func (a *Vec3) Lock() {}
+
+// Unlock - no-op
+func (a *Vec3) Unlock() {}
+
+// New
+func (a *Vec3) New_V() V {
+	return new(Vec3)
+}
+
+// Dup (copy)
+func (a *Vec3) Dup_V() V {
+	b := *a
+	return &b
+}
+
+// Get
+func (a *Vec3) Get_V(pos int) S {
+	return Scalar32(a[pos])
+}
+
+// Set
+func (a *Vec3) Set_V(pos int, b S) {
+	a[pos] = float32(b.(Scalar32))
+}
+
+// Add
+func (a *Vec3) Add_V(pos int, b V) {
+	a[pos] += b.(*Vec3)[pos]
+}
+
+// Subtract
+func (a *Vec3) Sub_V(pos int, b V) {
+	a[pos] -= b.(*Vec3)[pos]
+}
+
+// Multiply
+func (a *Vec3) Mul_V(pos int, b V) {
+	a[pos] *= b.(*Vec3)[pos]
+}
+
+// Divide
+func (a *Vec3) Div_V(pos int, b V) {
+	a[pos] /= b.(*Vec3)[pos]
+}
+
+// Add Scalar
+func (a *Vec3) AddSc_V(pos int, b S) {
+	a[pos] += float32(b.(Scalar32))
+}
+
+// Subtract Scalar
+func (a *Vec3) SubSc_V(pos int, b S) {
+	a[pos] -= float32(b.(Scalar32))
+}
+
+// Multiply Scalar
+func (a *Vec3) MulSc_V(pos int, b S) {
+	a[pos] *= float32(b.(Scalar32))
+}
+
+// Divide Scalar
+func (a *Vec3) DivSc_V(pos int, b S) {
+	a[pos] /= float32(b.(Scalar32))
+}
+
+// Negate a vector element
+func (a *Vec3) Neg_V(pos int) {
+	a[pos] = -a[pos]
+}
+
+// Vector length
+func (a *Vec3) Len_V() int {
+	return 3
+}
+
+// Minimum relative vector length
+func (a *Vec3) LenMin_V(b V) int {
+	_ = b.(*Vec3)
+	return 3
+}
+
+// Zero valued element
+func (a *Vec3) Zero_V() S {
+	return Scalar32(0)
+}
+
+// ... for the Vec4 V API
+
+// Lock - no-op
+func (a *Vec4) Lock() {}
+
+// Unlock - no-op
+func (a *Vec4) Unlock() {}
+
+// New
+func (a *Vec4) New_V() V {
+	return new(Vec4)
+}
+
+// Dup (copy)
+func (a *Vec4) Dup_V() V {
+	b := *a
+	return &b
+}
+
+// Get
+func (a *Vec4) Get_V(pos int) S {
+	return Scalar32(a[pos])
+}
+
+// Set
+func (a *Vec4) Set_V(pos int, b S) {
+	a[pos] = float32(b.(Scalar32))
+}
+
+// Add
+func (a *Vec4) Add_V(pos int, b V) {
+	a[pos] += b.(*Vec4)[pos]
+}
+
+// Subtract
+func (a *Vec4) Sub_V(pos int, b V) {
+	a[pos] -= b.(*Vec4)[pos]
+}
+
+// Multiply
+func (a *Vec4) Mul_V(pos int, b V) {
+	a[pos] *= b.(*Vec4)[pos]
+}
+
+// Divide
+func (a *Vec4) Div_V(pos int, b V) {
+	a[pos] /= b.(*Vec4)[pos]
+}
+
+// Add Scalar
+func (a *Vec4) AddSc_V(pos int, b S) {
+	a[pos] += float32(b.(Scalar32))
+}
+
+// Subtract Scalar
+func (a *Vec4) SubSc_V(pos int, b S) {
+	a[pos] -= float32(b.(Scalar32))
+}
+
+// Multiply Scalar
+func (a *Vec4) MulSc_V(pos int, b S) {
+	a[pos] *= float32(b.(Scalar32))
+}
+
+// Divide Scalar
+func (a *Vec4) DivSc_V(pos int, b S) {
+	a[pos] /= float32(b.(Scalar32))
+}
+
+// Negate a vector element
+func (a *Vec4) Neg_V(pos int) {
+	a[pos] = -a[pos]
+}
+
+// Vector length
+func (a *Vec4) Len_V() int {
+	return 4
+}
+
+// Minimum relative vector length
+func (a *Vec4) LenMin_V(b V) int {
+	_ = b.(*Vec4)
+	return 4
+}
+
+// Zero valued element
+func (a *Vec4) Zero_V() S {
+	return Scalar32(0)
+}
+
+// ... for the DVec2 V API
+
+// Lock - no-op
+func (a *DVec2) Lock() {}
+
+// Unlock - no-op
+func (a *DVec2) Unlock() {}
+
+// New
+func (a *DVec2) New_V() V {
+	return new(DVec2)
+}
+
+// Dup (copy)
+func (a *DVec2) Dup_V() V {
+	b := *a
+	return &b
+}
+
+// Get
+func (a *DVec2) Get_V(pos int) S {
+	return Scalar64(a[pos])
+}
+
+// Set
+func (a *DVec2) Set_V(pos int, b S) {
+	a[pos] = float64(b.(Scalar64))
+}
+
+// Add
+func (a *DVec2) Add_V(pos int, b V) {
+	a[pos] += b.(*DVec2)[pos]
+}
+
+// Subtract
+func (a *DVec2) Sub_V(pos int, b V) {
+	a[pos] -= b.(*DVec2)[pos]
+}
+
+// Multiply
+func (a *DVec2) Mul_V(pos int, b V) {
+	a[pos] *= b.(*DVec2)[pos]
+}
+
+// Divide
+func (a *DVec2) Div_V(pos int, b V) {
+	a[pos] /= b.(*DVec2)[pos]
+}
+
+// Add Scalar
+func (a *DVec2) AddSc_V(pos int, b S) {
+	a[pos] += float64(b.(Scalar64))
+}
+
+// Subtract Scalar
+func (a *DVec2) SubSc_V(pos int, b S) {
+	a[pos] -= float64(b.(Scalar64))
+}
+
+// Multiply Scalar
+func (a *DVec2) MulSc_V(pos int, b S) {
+	a[pos] *= float64(b.(Scalar64))
+}
+
+// Divide Scalar
+func (a *DVec2) DivSc_V(pos int, b S) {
+	a[pos] /= float64(b.(Scalar64))
+}
+
+// Negate a vector element
+func (a *DVec2) Neg_V(pos int) {
+	a[pos] = -a[pos]
+}
+
+// Vector length
+func (a *DVec2) Len_V() int {
+	return 2
+}
+
+// Minimum relative vector length
+func (a *DVec2) LenMin_V(b V) int {
+	_ = b.(*DVec2)
+	return 2
+}
+
+// Zero valued element
+func (a *DVec2) Zero_V() S {
+	return Scalar64(0)
+}
+
+// ... for the DVec3 V API
+
+// Lock - no-op
+func (a *DVec3) Lock() {}
+
+// Unlock - no-op
+func (a *DVec3) Unlock() {}
+
+// New
+func (a *DVec3) New_V() V {
+	return new(DVec3)
+}
+
+// Dup (copy)
+func (a *DVec3) Dup_V() V {
+	b := *a
+	return &b
+}
+
+// Get
+func (a *DVec3) Get_V(pos int) S {
+	return Scalar64(a[pos])
+}
+
+// Set
+func (a *DVec3) Set_V(pos int, b S) {
+	a[pos] = float64(b.(Scalar64))
+}
+
+// Add
+func (a *DVec3) Add_V(pos int, b V) {
+	a[pos] += b.(*DVec3)[pos]
+}
+
+// Subtract
+func (a *DVec3) Sub_V(pos int, b V) {
+	a[pos] -= b.(*DVec3)[pos]
+}
+
+// Multiply
+func (a *DVec3) Mul_V(pos int, b V) {
+	a[pos] *= b.(*DVec3)[pos]
+}
+
+// Divide
+func (a *DVec3) Div_V(pos int, b V) {
+	a[pos] /= b.(*DVec3)[pos]
+}
+
+// Add Scalar
+func (a *DVec3) AddSc_V(pos int, b S) {
+	a[pos] += float64(b.(Scalar64))
+}
+
+// Subtract Scalar
+func (a *DVec3) SubSc_V(pos int, b S) {
+	a[pos] -= float64(b.(Scalar64))
+}
+
+// Multiply Scalar
+func (a *DVec3) MulSc_V(pos int, b S) {
+	a[pos] *= float64(b.(Scalar64))
+}
+
+// Divide Scalar
+func (a *DVec3) DivSc_V(pos int, b S) {
+	a[pos] /= float64(b.(Scalar64))
+}
+
+// Negate a vector element
+func (a *DVec3) Neg_V(pos int) {
+	a[pos] = -a[pos]
+}
+
+// Vector length
+func (a *DVec3) Len_V() int {
+	return 3
+}
+
+// Minimum relative vector length
+func (a *DVec3) LenMin_V(b V) int {
+	_ = b.(*DVec3)
+	return 3
+}
+
+// Zero valued element
+func (a *DVec3) Zero_V() S {
+	return Scalar64(0)
+}
+
+// ... for the DVec4 V API
+
+// Lock - no-op
+func (a *DVec4) Lock() {}
+
+// Unlock - no-op
+func (a *DVec4) Unlock() {}
+
+// New
+func (a *DVec4) New_V() V {
+	return new(DVec4)
+}
+
+// Dup (copy)
+func (a *DVec4) Dup_V() V {
+	b := *a
+	return &b
+}
+
+// Get
+func (a *DVec4) Get_V(pos int) S {
+	return Scalar64(a[pos])
+}
+
+// Set
+func (a *DVec4) Set_V(pos int, b S) {
+	a[pos] = float64(b.(Scalar64))
+}
+
+// Add
+func (a *DVec4) Add_V(pos int, b V) {
+	a[pos] += b.(*DVec4)[pos]
+}
+
+// Subtract
+func (a *DVec4) Sub_V(pos int, b V) {
+	a[pos] -= b.(*DVec4)[pos]
+}
+
+// Multiply
+func (a *DVec4) Mul_V(pos int, b V) {
+	a[pos] *= b.(*DVec4)[pos]
+}
+
+// Divide
+func (a *DVec4) Div_V(pos int, b V) {
+	a[pos] /= b.(*DVec4)[pos]
+}
+
+// Add Scalar
+func (a *DVec4) AddSc_V(pos int, b S) {
+	a[pos] += float64(b.(Scalar64))
+}
+
+// Subtract Scalar
+func (a *DVec4) SubSc_V(pos int, b S) {
+	a[pos] -= float64(b.(Scalar64))
+}
+
+// Multiply Scalar
+func (a *DVec4) MulSc_V(pos int, b S) {
+	a[pos] *= float64(b.(Scalar64))
+}
+
+// Divide Scalar
+func (a *DVec4) DivSc_V(pos int, b S) {
+	a[pos] /= float64(b.(Scalar64))
+}
+
+// Negate a vector element
+func (a *DVec4) Neg_V(pos int) {
+	a[pos] = -a[pos]
+}
+
+// Vector length
+func (a *DVec4) Len_V() int {
+	return 4
+}
+
+// Minimum relative vector length
+func (a *DVec4) LenMin_V(b V) int {
+	_ = b.(*DVec4)
+	return 4
+}
+
+// Zero valued element
+func (a *DVec4) Zero_V() S {
+	return Scalar64(0)
+}
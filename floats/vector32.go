@@ -55,18 +55,47 @@ func (a *Vector32) DivVectors32(bs ...*Vector32) *Vector32 {
 	return Modify_V(a, DivOp, b...).(*Vector32)
 }
 
+// Add a scalar value to each vector element
+func (a *Vector32) AddScalar32(val float32) *Vector32 {
+	return ModifyScalar_V(a, AddOp, Scalar32(val)).(*Vector32)
+}
+
+// Subtract a scalar value from each vector element
+func (a *Vector32) SubScalar32(val float32) *Vector32 {
+	return ModifyScalar_V(a, SubOp, Scalar32(val)).(*Vector32)
+}
+
 // Multiply a vector by a scalar value
 func (a *Vector32) MulScalar32(val float32) *Vector32 {
-	b := NewVector32(1)
-	b.Elem[0] = Scalar32(val)
-	return Modify_V(a, MulOp, b).(*Vector32)
+	return ModifyScalar_V(a, MulOp, Scalar32(val)).(*Vector32)
 }
 
 // Divide a vector by a scalar value
 func (a *Vector32) DivScalar32(val float32) *Vector32 {
-	b := NewVector32(1)
-	b.Elem[0] = Scalar32(val)
-	return Modify_V(a, DivOp, b).(*Vector32)
+	return ModifyScalar_V(a, DivOp, Scalar32(val)).(*Vector32)
+}
+
+// Raise each vector element to the power of a scalar value
+func (a *Vector32) PowScalar32(val float32) *Vector32 {
+	return Pow_V(a, Scalar32(val)).(*Vector32)
+}
+
+// Hypotenuse of the receiver vector and a set of vectors
+func (a *Vector32) HypotVectors32(bs ...*Vector32) *Vector32 {
+	b := gen_V(bs...)
+	return Modify_V(a, HypotOp, b...).(*Vector32)
+}
+
+// Arc tangent of the receiver vector divided by a set of vectors, using
+// the signs of both to determine the quadrant
+func (a *Vector32) AtanVectors32(bs ...*Vector32) *Vector32 {
+	b := gen_V(bs...)
+	return Modify_V(a, AtanOp, b...).(*Vector32)
+}
+
+// Clamp each vector element to the range [lo, hi]
+func (a *Vector32) ClampScalar32(lo, hi float32) *Vector32 {
+	return Clamp_V(a, Scalar32(lo), Scalar32(hi)).(*Vector32)
 }
 
 // Negate a vector
@@ -74,17 +103,142 @@ func (a *Vector32) Negate32() *Vector32 {
 	return Negate_V(a).(*Vector32)
 }
 
+// Element-wise minimum of the receiver and a set of vectors
+func (a *Vector32) MinVectors32(bs ...*Vector32) *Vector32 {
+	b := gen_V(bs...)
+	return Min_V(a, b...).(*Vector32)
+}
+
+// Element-wise maximum of the receiver and a set of vectors
+func (a *Vector32) MaxVectors32(bs ...*Vector32) *Vector32 {
+	b := gen_V(bs...)
+	return Max_V(a, b...).(*Vector32)
+}
+
+// Absolute value of each vector element
+func (a *Vector32) Abs32() *Vector32 {
+	return Abs_V(a).(*Vector32)
+}
+
+// Square root of each vector element
+func (a *Vector32) Sqrt32() *Vector32 {
+	return Sqrt_V(a).(*Vector32)
+}
+
+// Round each vector element down
+func (a *Vector32) Floor32() *Vector32 {
+	return Floor_V(a).(*Vector32)
+}
+
+// Round each vector element up
+func (a *Vector32) Ceil32() *Vector32 {
+	return Ceil_V(a).(*Vector32)
+}
+
+// Round each vector element to the nearest integer, half away from zero
+func (a *Vector32) Round32() *Vector32 {
+	return Round_V(a).(*Vector32)
+}
+
+// Replace each vector element e with fn(e)
+func (a *Vector32) Map32(fn func(float32) float32) *Vector32 {
+	return Map_V(a, func(e S) S { return Scalar32(fn(float32(e.(Scalar32)))) }).(*Vector32)
+}
+
+// Replace each vector element e with fn(e, b[i]), where i is the element position
+func (a *Vector32) ZipWith32(b *Vector32, fn func(float32, float32) float32) *Vector32 {
+	return ZipWith_V(a, b, func(e, f S) S { return Scalar32(fn(float32(e.(Scalar32)), float32(f.(Scalar32)))) }).(*Vector32)
+}
+
 // Dot product of two vectors
 func (a *Vector32) Dot32(b *Vector32) float32 {
 	return float32(Dot_V(a, b).(Scalar32))
 }
 
+// Sum of the vector elements
+func (a *Vector32) Sum32() float32 {
+	return float32(Sum_V(a).(Scalar32))
+}
+
+// Product of the vector elements
+func (a *Vector32) Product32() float32 {
+	return float32(Product_V(a).(Scalar32))
+}
+
+// Norm of order p; see L1, L2 and LInf
+func (a *Vector32) Norm32(p float64) float32 {
+	return float32(Norm_V(a, p).(Scalar32))
+}
+
+// Distance between two vectors using the norm of order p
+func (a *Vector32) Distance32(b *Vector32, p float64) float32 {
+	return float32(Distance_V(a, b, p).(Scalar32))
+}
+
+// Approximate equality: true where the vectors have the same dimension and
+// each pair of elements differs by at most absTol, or at most relTol times
+// the larger element magnitude
+func (a *Vector32) ApproxEqual32(b *Vector32, absTol, relTol float64) bool {
+	return ApproxEqual_V(a, b, absTol, relTol)
+}
+
+// Cosine similarity of two vectors
+func (a *Vector32) CosineSimilarity32(b *Vector32) float32 {
+	return float32(CosineSimilarity_V(a, b).(Scalar32))
+}
+
 // Linear interpolation
 func (a *Vector32) Lerp32(b *Vector32, t float32) *Vector32 {
 	return Lerp_V(a, b, Scalar32(t)).(*Vector32)
 }
 
+// Normalized linear interpolation
+func (a *Vector32) NLerp32(b *Vector32, t float32) *Vector32 {
+	return NLerp_V(a, b, Scalar32(t)).(*Vector32)
+}
+
 // Spherical Linear interpolation
 func (a *Vector32) SLerp32(b *Vector32, t float32) *Vector32 {
 	return SLerp_V(a, b, Scalar32(t)).(*Vector32)
 }
+
+// Spherical Linear interpolation along the shortest arc
+func (a *Vector32) SLerpShortest32(b *Vector32, t float32) *Vector32 {
+	return SLerpShortest_V(a, b, Scalar32(t)).(*Vector32)
+}
+
+// Normalize to unit length; a zero length vector is left unchanged
+func (a *Vector32) Normalize32() *Vector32 {
+	return Normalize_V(a).(*Vector32)
+}
+
+// Cross product of two 3 element vectors
+func (a *Vector32) Cross32(b *Vector32) *Vector32 {
+	return Cross3_V(a, b).(*Vector32)
+}
+
+// Projection of the receiver onto b
+func (a *Vector32) Project32(b *Vector32) *Vector32 {
+	return Project_V(a, b).(*Vector32)
+}
+
+// Component of the receiver orthogonal to b
+func (a *Vector32) Reject32(b *Vector32) *Vector32 {
+	return Reject_V(a, b).(*Vector32)
+}
+
+// Reflection about the plane with the given unit normal
+func (a *Vector32) Reflect32(normal *Vector32) *Vector32 {
+	return Reflect_V(a, normal).(*Vector32)
+}
+
+// Refraction of the unit receiver through the surface with the given unit
+// normal, for the ratio of refractive indices eta
+func (a *Vector32) Refract32(normal *Vector32, eta float32) *Vector32 {
+	return Refract_V(a, normal, Scalar32(eta)).(*Vector32)
+}
+
+// Angle, in radians, between two vectors
+func (a *Vector32) Angle32(b *Vector32) float32 {
+	return float32(Angle_V(a, b).(Scalar32))
+}
@@ -5,6 +5,8 @@
 package floats
 
 import (
+	"cmp"
+	"math"
 	"sync"
 
 	. "github.com/grosenberg/maths/algorithms"
@@ -71,6 +73,16 @@ func (a *Vector32) Div_V(pos int, b V) {
 	a.Elem[pos] /= b.(*Vector32).Elem[pos]
 }
 
+// Add Scalar
+func (a *Vector32) AddSc_V(pos int, b S) {
+	a.Elem[pos] += b.(Scalar32)
+}
+
+// Subtract Scalar
+func (a *Vector32) SubSc_V(pos int, b S) {
+	a.Elem[pos] -= b.(Scalar32)
+}
+
 // Multiply Scalar
 func (a *Vector32) MulSc_V(pos int, b S) {
 	a.Elem[pos] *= b.(Scalar32)
@@ -101,6 +113,11 @@ func (a *Vector32) LenMin_V(b V) int {
 	return bl
 }
 
+// Zero valued element
+func (a *Vector32) Zero_V() S {
+	return Scalar32(0)
+}
+
 // ... for the S API
 
 // Add
@@ -123,6 +140,46 @@ func (a Scalar32) Div_S(b S) S {
 	return a / b.(Scalar32)
 }
 
+// Absolute value
+func (a Scalar32) Abs_S() S {
+	return Scalar32(math.Abs(float64(a)))
+}
+
+// Square root
+func (a Scalar32) Sqrt_S() S {
+	return Scalar32(math.Sqrt(float64(a)))
+}
+
+// Floor
+func (a Scalar32) Floor_S() S {
+	return Scalar32(math.Floor(float64(a)))
+}
+
+// Ceiling
+func (a Scalar32) Ceil_S() S {
+	return Scalar32(math.Ceil(float64(a)))
+}
+
+// Round, half away from zero
+func (a Scalar32) Round_S() S {
+	return Scalar32(math.Round(float64(a)))
+}
+
+// Compare, ordering NaN before all other values
+func (a Scalar32) Compare_S(b S) int {
+	return cmp.Compare(a, b.(Scalar32))
+}
+
+// Zero - receiver is ignored
+func (a Scalar32) Zero_S() S {
+	return Scalar32(0)
+}
+
+// One - receiver is ignored
+func (a Scalar32) One_S() S {
+	return Scalar32(1)
+}
+
 // Convert Scalar to a float
 func (a Scalar32) ToFloat() float64 {
 	return float64(a)
@@ -0,0 +1,247 @@
+// Copyright © 2015 Gerald Rosenberg.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the License.md file.
+//
+package floats
+
+import . "github.com/grosenberg/maths/algorithms"
+
+// Vector and Scalar default to the float64 specialization
+type (
+	Vector = Vector64
+	Scalar = Scalar64
+)
+
+/////////////////////////////////////////////////////////////
+// Vector type-specific API
+
+// Create a new Vector of the given dimension
+func NewVector64(dim int) *Vector64 {
+	v := &Vector64{}
+	v.Elem = make([]Scalar64, dim)
+	return v
+}
+
+// Create a new default, float64 valued Vector of the given dimension
+func NewVector(dim int) *Vector {
+	return NewVector64(dim)
+}
+
+// Create a copy of an existing Vector
+func (a *Vector64) CopyVector64() *Vector64 {
+	b := NewVector64(a.Len_V())
+	b.AddVectors64(a)
+	return b
+}
+
+// Add a set of vectors to the receiver vector
+func (a *Vector64) AddVectors64(bs ...*Vector64) *Vector64 {
+	b := gen_V64(bs...)
+	return Modify_V(a, AddOp, b...).(*Vector64)
+}
+
+// Subtract a set of vectors from the receiver vector
+func (a *Vector64) SubVectors64(bs ...*Vector64) *Vector64 {
+	b := gen_V64(bs...)
+	return Modify_V(a, SubOp, b...).(*Vector64)
+}
+
+// Multiply a set of vectors against the receiver vector
+func (a *Vector64) MulVectors64(bs ...*Vector64) *Vector64 {
+	b := gen_V64(bs...)
+	return Modify_V(a, MulOp, b...).(*Vector64)
+}
+
+// Divide a set of vectors against the receiver vector
+func (a *Vector64) DivVectors64(bs ...*Vector64) *Vector64 {
+	b := gen_V64(bs...)
+	return Modify_V(a, DivOp, b...).(*Vector64)
+}
+
+// Add a scalar value to each vector element
+func (a *Vector64) AddScalar64(val float64) *Vector64 {
+	return ModifyScalar_V(a, AddOp, Scalar64(val)).(*Vector64)
+}
+
+// Subtract a scalar value from each vector element
+func (a *Vector64) SubScalar64(val float64) *Vector64 {
+	return ModifyScalar_V(a, SubOp, Scalar64(val)).(*Vector64)
+}
+
+// Multiply a vector by a scalar value
+func (a *Vector64) MulScalar64(val float64) *Vector64 {
+	return ModifyScalar_V(a, MulOp, Scalar64(val)).(*Vector64)
+}
+
+// Divide a vector by a scalar value
+func (a *Vector64) DivScalar64(val float64) *Vector64 {
+	return ModifyScalar_V(a, DivOp, Scalar64(val)).(*Vector64)
+}
+
+// Raise each vector element to the power of a scalar value
+func (a *Vector64) PowScalar64(val float64) *Vector64 {
+	return Pow_V(a, Scalar64(val)).(*Vector64)
+}
+
+// Hypotenuse of the receiver vector and a set of vectors
+func (a *Vector64) HypotVectors64(bs ...*Vector64) *Vector64 {
+	b := gen_V64(bs...)
+	return Modify_V(a, HypotOp, b...).(*Vector64)
+}
+
+// Arc tangent of the receiver vector divided by a set of vectors, using
+// the signs of both to determine the quadrant
+func (a *Vector64) AtanVectors64(bs ...*Vector64) *Vector64 {
+	b := gen_V64(bs...)
+	return Modify_V(a, AtanOp, b...).(*Vector64)
+}
+
+// Clamp each vector element to the range [lo, hi]
+func (a *Vector64) ClampScalar64(lo, hi float64) *Vector64 {
+	return Clamp_V(a, Scalar64(lo), Scalar64(hi)).(*Vector64)
+}
+
+// Negate a vector
+func (a *Vector64) Negate64() *Vector64 {
+	return Negate_V(a).(*Vector64)
+}
+
+// Element-wise minimum of the receiver and a set of vectors
+func (a *Vector64) MinVectors64(bs ...*Vector64) *Vector64 {
+	b := gen_V64(bs...)
+	return Min_V(a, b...).(*Vector64)
+}
+
+// Element-wise maximum of the receiver and a set of vectors
+func (a *Vector64) MaxVectors64(bs ...*Vector64) *Vector64 {
+	b := gen_V64(bs...)
+	return Max_V(a, b...).(*Vector64)
+}
+
+// Absolute value of each vector element
+func (a *Vector64) Abs64() *Vector64 {
+	return Abs_V(a).(*Vector64)
+}
+
+// Square root of each vector element
+func (a *Vector64) Sqrt64() *Vector64 {
+	return Sqrt_V(a).(*Vector64)
+}
+
+// Round each vector element down
+func (a *Vector64) Floor64() *Vector64 {
+	return Floor_V(a).(*Vector64)
+}
+
+// Round each vector element up
+func (a *Vector64) Ceil64() *Vector64 {
+	return Ceil_V(a).(*Vector64)
+}
+
+// Round each vector element to the nearest integer, half away from zero
+func (a *Vector64) Round64() *Vector64 {
+	return Round_V(a).(*Vector64)
+}
+
+// Replace each vector element e with fn(e)
+func (a *Vector64) Map64(fn func(float64) float64) *Vector64 {
+	return Map_V(a, func(e S) S { return Scalar64(fn(float64(e.(Scalar64)))) }).(*Vector64)
+}
+
+// Replace each vector element e with fn(e, b[i]), where i is the element position
+func (a *Vector64) ZipWith64(b *Vector64, fn func(float64, float64) float64) *Vector64 {
+	return ZipWith_V(a, b, func(e, f S) S { return Scalar64(fn(float64(e.(Scalar64)), float64(f.(Scalar64)))) }).(*Vector64)
+}
+
+// Dot product of two vectors
+func (a *Vector64) Dot64(b *Vector64) float64 {
+	return float64(Dot_V(a, b).(Scalar64))
+}
+
+// Sum of the vector elements
+func (a *Vector64) Sum64() float64 {
+	return float64(Sum_V(a).(Scalar64))
+}
+
+// Product of the vector elements
+func (a *Vector64) Product64() float64 {
+	return float64(Product_V(a).(Scalar64))
+}
+
+// Norm of order p; see L1, L2 and LInf
+func (a *Vector64) Norm64(p float64) float64 {
+	return float64(Norm_V(a, p).(Scalar64))
+}
+
+// Distance between two vectors using the norm of order p
+func (a *Vector64) Distance64(b *Vector64, p float64) float64 {
+	return float64(Distance_V(a, b, p).(Scalar64))
+}
+
+// Approximate equality: true where the vectors have the same dimension and
+// each pair of elements differs by at most absTol, or at most relTol times
+// the larger element magnitude
+func (a *Vector64) ApproxEqual64(b *Vector64, absTol, relTol float64) bool {
+	return ApproxEqual_V(a, b, absTol, relTol)
+}
+
+// Cosine similarity of two vectors
+func (a *Vector64) CosineSimilarity64(b *Vector64) float64 {
+	return float64(CosineSimilarity_V(a, b).(Scalar64))
+}
+
+// Linear interpolation
+func (a *Vector64) Lerp64(b *Vector64, t float64) *Vector64 {
+	return Lerp_V(a, b, Scalar64(t)).(*Vector64)
+}
+
+// Normalized linear interpolation
+func (a *Vector64) NLerp64(b *Vector64, t float64) *Vector64 {
+	return NLerp_V(a, b, Scalar64(t)).(*Vector64)
+}
+
+// Spherical Linear interpolation
+func (a *Vector64) SLerp64(b *Vector64, t float64) *Vector64 {
+	return SLerp_V(a, b, Scalar64(t)).(*Vector64)
+}
+
+// Spherical Linear interpolation along the shortest arc
+func (a *Vector64) SLerpShortest64(b *Vector64, t float64) *Vector64 {
+	return SLerpShortest_V(a, b, Scalar64(t)).(*Vector64)
+}
+
+// Normalize to unit length; a zero length vector is left unchanged
+func (a *Vector64) Normalize64() *Vector64 {
+	return Normalize_V(a).(*Vector64)
+}
+
+// Cross product of two 3 element vectors
+func (a *Vector64) Cross64(b *Vector64) *Vector64 {
+	return Cross3_V(a, b).(*Vector64)
+}
+
+// Projection of the receiver onto b
+func (a *Vector64) Project64(b *Vector64) *Vector64 {
+	return Project_V(a, b).(*Vector64)
+}
+
+// Component of the receiver orthogonal to b
+func (a *Vector64) Reject64(b *Vector64) *Vector64 {
+	return Reject_V(a, b).(*Vector64)
+}
+
+// Reflection about the plane with the given unit normal
+func (a *Vector64) Reflect64(normal *Vector64) *Vector64 {
+	return Reflect_V(a, normal).(*Vector64)
+}
+
+// Refraction of the unit receiver through the surface with the given unit
+// normal, for the ratio of refractive indices eta
+func (a *Vector64) Refract64(normal *Vector64, eta float64) *Vector64 {
+	return Refract_V(a, normal, Scalar64(eta)).(*Vector64)
+}
+
+// Angle, in radians, between two vectors
+func (a *Vector64) Angle64(b *Vector64) float64 {
+	return float64(Angle_V(a, b).(Scalar64))
+}
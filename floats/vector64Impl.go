@@ -0,0 +1,202 @@
+// Copyright © 2015 Gerald Rosenberg.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the License.md file.
+//
+package floats
+
+import (
+	"cmp"
+	"math"
+	"sync"
+
+	. "github.com/grosenberg/maths/algorithms"
+)
+
+// Type specfic composite 'value' compatible with the
+// intended generic type algorithm implemenetation.
+type Vector64 struct {
+	sync.Mutex
+	Elem []Scalar64
+}
+
+// Type specfic simple 'value' compatible with the
+// intended generic type algorithm implemenetation.
+type Scalar64 float64
+
+/////////////////////////////////////////////////////////////
+// Compile-time implementation prover
+var _ V = &Vector64{}
+var _ S = Scalar64(0)
+
+/////////////////////////////////////////////////////////////
+// Type-specific support for generic implementation
+
+// ... for the V API
+
+// New
+func (a *Vector64) New_V() V {
+	return NewVector64(a.Len_V())
+}
+
+// Dup (copy)
+func (a *Vector64) Dup_V() V {
+	return a.CopyVector64()
+}
+
+// Get
+func (a *Vector64) Get_V(pos int) S {
+	return Scalar64(a.Elem[pos])
+}
+
+// Set
+func (a *Vector64) Set_V(pos int, b S) {
+	a.Elem[pos] = b.(Scalar64)
+}
+
+// Add
+func (a *Vector64) Add_V(pos int, b V) {
+	a.Elem[pos] += b.(*Vector64).Elem[pos]
+}
+
+// Subtract
+func (a *Vector64) Sub_V(pos int, b V) {
+	a.Elem[pos] -= b.(*Vector64).Elem[pos]
+}
+
+// Multiply
+func (a *Vector64) Mul_V(pos int, b V) {
+	a.Elem[pos] *= b.(*Vector64).Elem[pos]
+}
+
+// Divide
+func (a *Vector64) Div_V(pos int, b V) {
+	a.Elem[pos] /= b.(*Vector64).Elem[pos]
+}
+
+// Add Scalar
+func (a *Vector64) AddSc_V(pos int, b S) {
+	a.Elem[pos] += b.(Scalar64)
+}
+
+// Subtract Scalar
+func (a *Vector64) SubSc_V(pos int, b S) {
+	a.Elem[pos] -= b.(Scalar64)
+}
+
+// Multiply Scalar
+func (a *Vector64) MulSc_V(pos int, b S) {
+	a.Elem[pos] *= b.(Scalar64)
+}
+
+// Divide Scalar
+func (a *Vector64) DivSc_V(pos int, b S) {
+	a.Elem[pos] /= b.(Scalar64)
+}
+
+// Negate a vector element
+func (a *Vector64) Neg_V(pos int) {
+	a.Elem[pos] = -a.Elem[pos]
+}
+
+// Vector length
+func (a *Vector64) Len_V() int {
+	return len(a.Elem)
+}
+
+// Minimum relative vector length
+func (a *Vector64) LenMin_V(b V) int {
+	al := len(a.Elem)
+	bl := len(b.(*Vector64).Elem)
+	if al < bl {
+		return al
+	}
+	return bl
+}
+
+// Zero valued element
+func (a *Vector64) Zero_V() S {
+	return Scalar64(0)
+}
+
+// ... for the S API
+
+// Add
+func (a Scalar64) Add_S(b S) S {
+	return a + b.(Scalar64)
+}
+
+// Subtract
+func (a Scalar64) Sub_S(b S) S {
+	return a - b.(Scalar64)
+}
+
+// Multiply
+func (a Scalar64) Mul_S(b S) S {
+	return a * b.(Scalar64)
+}
+
+// Divide
+func (a Scalar64) Div_S(b S) S {
+	return a / b.(Scalar64)
+}
+
+// Absolute value
+func (a Scalar64) Abs_S() S {
+	return Scalar64(math.Abs(float64(a)))
+}
+
+// Square root
+func (a Scalar64) Sqrt_S() S {
+	return Scalar64(math.Sqrt(float64(a)))
+}
+
+// Floor
+func (a Scalar64) Floor_S() S {
+	return Scalar64(math.Floor(float64(a)))
+}
+
+// Ceiling
+func (a Scalar64) Ceil_S() S {
+	return Scalar64(math.Ceil(float64(a)))
+}
+
+// Round, half away from zero
+func (a Scalar64) Round_S() S {
+	return Scalar64(math.Round(float64(a)))
+}
+
+// Compare, ordering NaN before all other values
+func (a Scalar64) Compare_S(b S) int {
+	return cmp.Compare(a, b.(Scalar64))
+}
+
+// Zero - receiver is ignored
+func (a Scalar64) Zero_S() S {
+	return Scalar64(0)
+}
+
+// One - receiver is ignored
+func (a Scalar64) One_S() S {
+	return Scalar64(1)
+}
+
+// Convert Scalar to a float
+func (a Scalar64) ToFloat() float64 {
+	return float64(a)
+}
+
+// Convert a float to a Scalar - receiver is ignored
+func (a Scalar64) ToS(v float64) S {
+	return Scalar64(v)
+}
+
+// Helper function
+
+// gen_V64 promotes the base type to []V
+func gen_V64(bi ...*Vector64) []V {
+	b := make([]V, len(bi))
+	for i, v := range bi {
+		b[i] = v
+	}
+	return b
+}
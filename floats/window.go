@@ -0,0 +1,111 @@
+// Copyright © 2015 Gerald Rosenberg.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the License.md file.
+//
+package floats
+
+import (
+	"time"
+
+	. "github.com/grosenberg/maths/algorithms"
+)
+
+/////////////////////////////////////////////////////////////
+// WindowRegister type-specific implementations
+
+// NewWindowRegister creates a new WindowRegister that computes the mean of
+// the last size values contributed. A size less than one is taken as one.
+func NewWindowRegister(size int) *WindowRegister {
+	if size < 1 {
+		size = 1
+	}
+	return &WindowRegister{buf: make([]float64, size)}
+}
+
+// Accumulate adds the given values to the register values and
+// returns the current count of values in the window.
+func (reg *WindowRegister) Accumulate(b ...float64) int {
+	reg.Lock()
+	defer reg.Unlock()
+
+	return Accumulate_R(reg, b).(int)
+}
+
+// Merge adds the values in the windows of the given registers, in order, as
+// following the register values and returns the current count of values in
+// the window. The given registers are unchanged.
+func (reg *WindowRegister) Merge(others ...*WindowRegister) int {
+	o := make([]R, len(others))
+	for i, r := range others {
+		o[i] = r
+	}
+	return MergeAll_R(reg, o...).(int)
+}
+
+// Compute updates and returns the mean of the values in the window
+func (reg *WindowRegister) Compute() float64 {
+	reg.Lock()
+	defer reg.Unlock()
+
+	return Compute_R(reg).(float64)
+}
+
+// Reset clears the register values and returns the prior calculated value
+func (reg *WindowRegister) Reset() float64 {
+	reg.Lock()
+	defer reg.Unlock()
+
+	return Reset_R(reg).(float64)
+}
+
+/////////////////////////////////////////////////////////////
+// TimeWindowRegister type-specific implementations
+
+// Time-stamped value contributed to a TimeWindowRegister
+type Sample struct {
+	T time.Time
+	V float64
+}
+
+// NewTimeWindowRegister creates a new TimeWindowRegister that computes the
+// mean of the values time-stamped within the given duration of the newest
+// value contributed.
+func NewTimeWindowRegister(window time.Duration) *TimeWindowRegister {
+	return &TimeWindowRegister{window: window}
+}
+
+// Accumulate adds the given samples to the register values and
+// returns the current count of values in the window.
+func (reg *TimeWindowRegister) Accumulate(b ...Sample) int {
+	reg.Lock()
+	defer reg.Unlock()
+
+	return Accumulate_R(reg, b).(int)
+}
+
+// Merge adds the samples in the windows of the given registers to the
+// register values and returns the current count of values in the window.
+// The given registers are unchanged.
+func (reg *TimeWindowRegister) Merge(others ...*TimeWindowRegister) int {
+	o := make([]R, len(others))
+	for i, r := range others {
+		o[i] = r
+	}
+	return MergeAll_R(reg, o...).(int)
+}
+
+// Compute updates and returns the mean of the values in the window
+func (reg *TimeWindowRegister) Compute() float64 {
+	reg.Lock()
+	defer reg.Unlock()
+
+	return Compute_R(reg).(float64)
+}
+
+// Reset clears the register values and returns the prior calculated value
+func (reg *TimeWindowRegister) Reset() float64 {
+	reg.Lock()
+	defer reg.Unlock()
+
+	return Reset_R(reg).(float64)
+}
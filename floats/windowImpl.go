@@ -0,0 +1,154 @@
+// Copyright © 2015 Gerald Rosenberg.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the License.md file.
+//
+package floats
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	. "github.com/grosenberg/maths/algorithms"
+)
+
+// Type specfic composite 'value' compatible with the
+// intended generic type algorithm implemenetation.
+type WindowRegister struct {
+	sync.Mutex
+	reg   float64   // store for the final (or current) computed value
+	buf   []float64 // ring buffer of the values in the window
+	next  int       // buffer position of the next value
+	count int       // count of values in the window
+}
+
+// Type specfic composite 'value' compatible with the
+// intended generic type algorithm implemenetation.
+type TimeWindowRegister struct {
+	sync.Mutex
+	reg     float64       // store for the final (or current) computed value
+	window  time.Duration // window duration
+	samples []Sample      // samples in the window, in time order
+}
+
+/////////////////////////////////////////////////////////////
+// Compile-time implementation prover
+var _ R = &WindowRegister{}
+var _ R = &TimeWindowRegister{}
+
+/////////////////////////////////////////////////////////////
+// Type-specific support for generic implementation
+
+// ... for WindowRegister
+
+// New creates a new empty WindowRegister of the same size.
+func (r *WindowRegister) New_R() R {
+	return NewWindowRegister(len(r.buf))
+}
+
+// Add adds the value of the given parameter to the window, evicting the
+// oldest value where the window is full, modifying the receiver.
+func (r *WindowRegister) Add_R(q Q) {
+	r.buf[r.next] = q.(float64)
+	r.next = (r.next + 1) % len(r.buf)
+	if r.count < len(r.buf) {
+		r.count++
+	}
+}
+
+// Merge adds the values in the window of the given register, oldest first,
+// modifying the receiver.
+func (r *WindowRegister) Merge_R(o R) {
+	b := o.(*WindowRegister)
+	start := b.next - b.count + len(b.buf)
+	for i := 0; i < b.count; i++ {
+		r.Add_R(b.buf[(start+i)%len(b.buf)])
+	}
+}
+
+// Update computes and stores the mean of the values in the window,
+// modifying the receiver.
+func (r *WindowRegister) Update_R() {
+	if r.count == 0 {
+		r.reg = 0
+		return
+	}
+	sum := 0.0
+	for i := 0; i < r.count; i++ {
+		sum += r.buf[(r.next-1-i+len(r.buf))%len(r.buf)]
+	}
+	r.reg = sum / float64(r.count)
+}
+
+func (r *WindowRegister) Value_R() Q {
+	return r.reg
+}
+
+func (r *WindowRegister) Count_R() Q {
+	return r.count
+}
+
+func (r *WindowRegister) Clear_R() {
+	r.reg = 0
+	r.next = 0
+	r.count = 0
+}
+
+// ... for TimeWindowRegister
+
+// New creates a new empty TimeWindowRegister of the same window duration.
+func (r *TimeWindowRegister) New_R() R {
+	return NewTimeWindowRegister(r.window)
+}
+
+// Add adds the sample of the given parameter to the window, in time order,
+// and evicts the samples no longer within the window duration of the
+// newest sample, modifying the receiver.
+func (r *TimeWindowRegister) Add_R(q Q) {
+	s := q.(Sample)
+	i := sort.Search(len(r.samples), func(i int) bool { return r.samples[i].T.After(s.T) })
+	r.samples = append(r.samples, Sample{})
+	copy(r.samples[i+1:], r.samples[i:])
+	r.samples[i] = s
+
+	cutoff := r.samples[len(r.samples)-1].T.Add(-r.window)
+	i = sort.Search(len(r.samples), func(i int) bool { return r.samples[i].T.After(cutoff) })
+	if i > 0 {
+		r.samples = append(r.samples[:0], r.samples[i:]...)
+	}
+}
+
+// Merge adds the samples in the window of the given register, modifying
+// the receiver.
+func (r *TimeWindowRegister) Merge_R(o R) {
+	for _, s := range o.(*TimeWindowRegister).samples {
+		r.Add_R(s)
+	}
+}
+
+// Update computes and stores the mean of the values in the window,
+// modifying the receiver.
+func (r *TimeWindowRegister) Update_R() {
+	if len(r.samples) == 0 {
+		r.reg = 0
+		return
+	}
+	sum := 0.0
+	for _, s := range r.samples {
+		sum += s.V
+	}
+	r.reg = sum / float64(len(r.samples))
+}
+
+func (r *TimeWindowRegister) Value_R() Q {
+	return r.reg
+}
+
+func (r *TimeWindowRegister) Count_R() Q {
+	return len(r.samples)
+}
+
+func (r *TimeWindowRegister) Clear_R() {
+	r.reg = 0
+	r.samples = nil
+}
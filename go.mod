@@ -0,0 +1,3 @@
+module github.com/grosenberg/maths
+
+go 1.21
@@ -27,6 +27,28 @@ func (reg *Register) Accumulate(b ...int) int {
 	return Accumulate_R(reg, b).(int)
 }
 
+// AccumulateParallel adds the given values to the register values using the
+// given number of workers, or GOMAXPROCS workers where not positive, and
+// returns the current count of value contributions. The result is
+// deterministic for a given number of workers.
+func (reg *Register) AccumulateParallel(b []int, workers int) int {
+	reg.Lock()
+	defer reg.Unlock()
+
+	return AccumulateParallel_R(reg, b, workers).(int)
+}
+
+// Merge adds the contributions of the given registers, in order, to the
+// register values and returns the current count of value contributions.
+// The given registers are unchanged.
+func (reg *Register) Merge(others ...*Register) int {
+	o := make([]R, len(others))
+	for i, r := range others {
+		o[i] = r
+	}
+	return MergeAll_R(reg, o...).(int)
+}
+
 // Compute updates and returns the calculated value
 func (reg *Register) Compute() int {
 	reg.Lock()
@@ -42,3 +64,10 @@ func (reg *Register) Reset() int {
 
 	return Reset_R(reg).(int)
 }
+
+// Average returns the integer average of the given values.
+func Average(vals ...int) int {
+	reg := NewRegister()
+	reg.Accumulate(vals...)
+	return reg.Compute()
+}
@@ -55,9 +55,26 @@ func (r *Register) Add_R(q Q) {
 	r.count++
 }
 
+// New creates a new empty Register.
+func (r *Register) New_R() R {
+	return NewRegister()
+}
+
+// Merge adds the contributions of the given register to the receiver,
+// modifying the receiver.
+func (r *Register) Merge_R(o R) {
+	b := o.(*Register)
+	r.accum += b.accum
+	r.count += b.count
+}
+
 // Update computes and stores the current average based on the value of the
 // given parameter, modifying the receiver.
 func (r *Register) Update_R() {
+	if r.count == 0 {
+		r.reg = 0
+		return
+	}
 	r.reg = r.accum / r.count
 }
 
@@ -0,0 +1,172 @@
+// Copyright © 2015 Gerald Rosenberg.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the License.md file.
+//
+package ints
+
+import . "github.com/grosenberg/maths/algorithms"
+
+// Named vector element positions
+const (
+	X = iota
+	Y
+	Z
+	W
+)
+
+/////////////////////////////////////////////////////////////
+// Vector type-specific API
+
+// Create a new Vector of the given dimension, using truncated
+// division and unchecked arithmetic
+func NewVector(dim int) *Vector {
+	v := &Vector{}
+	v.Elem = make([]Scalar, dim)
+	return v
+}
+
+// Create a copy of an existing Vector, retaining its division and checked modes
+func (a *Vector) CopyVector() *Vector {
+	b := a.New_V().(*Vector)
+	copy(b.Elem, a.Elem)
+	return b
+}
+
+// Err returns and clears the first overflow or division by zero
+// detected by an element-wise operation since the last call, where the
+// vector is checked. Reductions to a scalar are not checked.
+func (a *Vector) Err() error {
+	return a.Err_V()
+}
+
+// Add a set of vectors to the receiver vector
+func (a *Vector) AddVectors(bs ...*Vector) *Vector {
+	b := gen_V(bs...)
+	return Modify_V(a, AddOp, b...).(*Vector)
+}
+
+// Subtract a set of vectors from the receiver vector
+func (a *Vector) SubVectors(bs ...*Vector) *Vector {
+	b := gen_V(bs...)
+	return Modify_V(a, SubOp, b...).(*Vector)
+}
+
+// Multiply a set of vectors against the receiver vector
+func (a *Vector) MulVectors(bs ...*Vector) *Vector {
+	b := gen_V(bs...)
+	return Modify_V(a, MulOp, b...).(*Vector)
+}
+
+// Divide a set of vectors against the receiver vector, using
+// the receiver's division semantics
+func (a *Vector) DivVectors(bs ...*Vector) *Vector {
+	b := gen_V(bs...)
+	return Modify_V(a, DivOp, b...).(*Vector)
+}
+
+// Add a scalar value to each vector element
+func (a *Vector) AddScalar(val int64) *Vector {
+	return ModifyScalar_V(a, AddOp, Scalar(val)).(*Vector)
+}
+
+// Subtract a scalar value from each vector element
+func (a *Vector) SubScalar(val int64) *Vector {
+	return ModifyScalar_V(a, SubOp, Scalar(val)).(*Vector)
+}
+
+// Multiply a vector by a scalar value
+func (a *Vector) MulScalar(val int64) *Vector {
+	return ModifyScalar_V(a, MulOp, Scalar(val)).(*Vector)
+}
+
+// Divide a vector by a scalar value, using the receiver's division semantics
+func (a *Vector) DivScalar(val int64) *Vector {
+	return ModifyScalar_V(a, DivOp, Scalar(val)).(*Vector)
+}
+
+// Remainder of the receiver vector divided by a set of vectors, using the
+// receiver's division semantics
+func (a *Vector) ModVectors(bs ...*Vector) *Vector {
+	b := gen_V(bs...)
+	return Modify_V(a, ModOp, b...).(*Vector)
+}
+
+// Remainder of each vector element divided by a scalar value, using the
+// receiver's division semantics
+func (a *Vector) ModScalar(val int64) *Vector {
+	return ModifyScalar_V(a, ModOp, Scalar(val)).(*Vector)
+}
+
+// Clamp each vector element to the range [lo, hi]
+func (a *Vector) ClampScalar(lo, hi int64) *Vector {
+	return Clamp_V(a, Scalar(lo), Scalar(hi)).(*Vector)
+}
+
+// Negate a vector
+func (a *Vector) Negate() *Vector {
+	return Negate_V(a).(*Vector)
+}
+
+// Element-wise minimum of the receiver and a set of vectors
+func (a *Vector) MinVectors(bs ...*Vector) *Vector {
+	b := gen_V(bs...)
+	return Min_V(a, b...).(*Vector)
+}
+
+// Element-wise maximum of the receiver and a set of vectors
+func (a *Vector) MaxVectors(bs ...*Vector) *Vector {
+	b := gen_V(bs...)
+	return Max_V(a, b...).(*Vector)
+}
+
+// Absolute value of each vector element
+func (a *Vector) Abs() *Vector {
+	return Abs_V(a).(*Vector)
+}
+
+// Square root of each vector element, rounded down; zero for negative values
+func (a *Vector) Sqrt() *Vector {
+	return Sqrt_V(a).(*Vector)
+}
+
+// Replace each vector element e with fn(e)
+func (a *Vector) Map(fn func(int64) int64) *Vector {
+	return Map_V(a, func(e S) S { return Scalar(fn(int64(e.(Scalar)))) }).(*Vector)
+}
+
+// Replace each vector element e with fn(e, b[i]), where i is the element position
+func (a *Vector) ZipWith(b *Vector, fn func(int64, int64) int64) *Vector {
+	return ZipWith_V(a, b, func(e, f S) S { return Scalar(fn(int64(e.(Scalar)), int64(f.(Scalar)))) }).(*Vector)
+}
+
+// Dot product of two vectors
+func (a *Vector) Dot(b *Vector) int64 {
+	return int64(Dot_V(a, b).(Scalar))
+}
+
+// Sum of the vector elements
+func (a *Vector) Sum() int64 {
+	return int64(Sum_V(a).(Scalar))
+}
+
+// Product of the vector elements
+func (a *Vector) Product() int64 {
+	return int64(Product_V(a).(Scalar))
+}
+
+// Norm of order p; see L1, L2 and LInf. The L2 norm is rounded
+// down and other orders are rounded to the nearest integer.
+func (a *Vector) Norm(p float64) int64 {
+	return int64(Norm_V(a, p).(Scalar))
+}
+
+// Distance between two vectors using the norm of order p
+func (a *Vector) Distance(b *Vector, p float64) int64 {
+	return int64(Distance_V(a, b, p).(Scalar))
+}
+
+// Exact equality: true where the vectors have the same dimension
+// and equal elements
+func (a *Vector) Equal(b *Vector) bool {
+	return Equal_V(a, b)
+}
@@ -0,0 +1,363 @@
+// Copyright © 2015 Gerald Rosenberg.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the License.md file.
+//
+package ints
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"sync"
+
+	. "github.com/grosenberg/maths/algorithms"
+)
+
+// Integer division semantics
+type DivMode int
+
+const (
+	// Truncated division rounds the quotient toward zero, as Go does.
+	Truncated DivMode = iota
+	// Floored division rounds the quotient toward negative infinity.
+	Floored
+	// Euclidean division yields a non-negative remainder.
+	Euclidean
+)
+
+// Type specfic composite 'value' compatible with the
+// intended generic type algorithm implemenetation.
+type Vector struct {
+	sync.Mutex
+	Elem []Scalar
+
+	Division DivMode // element division semantics
+
+	// Checked element-wise operations report overflow and division by zero
+	// rather than wrap or panic. Reductions to a scalar, such as Dot, Sum,
+	// Product, Norm and Distance, are not checked and wrap silently.
+	Checked bool
+	err     error // first error detected in checked mode
+}
+
+// Type specfic simple 'value' compatible with the
+// intended generic type algorithm implemenetation.
+type Scalar int64
+
+/////////////////////////////////////////////////////////////
+// Compile-time implementation prover
+var _ V = &Vector{}
+var _ E = &Vector{}
+var _ S = Scalar(0)
+
+/////////////////////////////////////////////////////////////
+// Type-specific operations
+
+// Remainder operation, using the receiver's division semantics: the
+// remainder takes the sign of the dividend for Truncated division, the
+// sign of the divisor for Floored division, and is never negative for
+// Euclidean division. Supported only by Vector.
+var ModOp = RegisterDivisorOp("mod",
+	func(res V, pos int, src V) error {
+		b, ok := src.(*Vector)
+		if !ok {
+			return fmt.Errorf("%w: mod of %T by %T", ErrUnsupportedOp, res, src)
+		}
+		return modSc_V(res, pos, b.Elem[pos])
+	},
+	modSc_V,
+)
+
+/////////////////////////////////////////////////////////////
+// Type-specific support for generic implementation
+
+// ... for the V API
+
+// New - retains the division and checked modes
+func (a *Vector) New_V() V {
+	b := NewVector(a.Len_V())
+	b.Division, b.Checked = a.Division, a.Checked
+	return b
+}
+
+// Dup (copy) - retains the division and checked modes
+func (a *Vector) Dup_V() V {
+	return a.CopyVector()
+}
+
+// Get
+func (a *Vector) Get_V(pos int) S {
+	return a.Elem[pos]
+}
+
+// Set
+func (a *Vector) Set_V(pos int, b S) {
+	a.Elem[pos] = b.(Scalar)
+}
+
+// Add
+func (a *Vector) Add_V(pos int, b V) {
+	a.AddSc_V(pos, b.(*Vector).Elem[pos])
+}
+
+// Subtract
+func (a *Vector) Sub_V(pos int, b V) {
+	a.SubSc_V(pos, b.(*Vector).Elem[pos])
+}
+
+// Multiply
+func (a *Vector) Mul_V(pos int, b V) {
+	a.MulSc_V(pos, b.(*Vector).Elem[pos])
+}
+
+// Divide
+func (a *Vector) Div_V(pos int, b V) {
+	a.DivSc_V(pos, b.(*Vector).Elem[pos])
+}
+
+// Add Scalar
+func (a *Vector) AddSc_V(pos int, b S) {
+	x, y := a.Elem[pos], b.(Scalar)
+	z := x + y
+	if a.Checked && (x^z)&(y^z) < 0 {
+		a.fail(ErrOverflow, pos)
+		return
+	}
+	a.Elem[pos] = z
+}
+
+// Subtract Scalar
+func (a *Vector) SubSc_V(pos int, b S) {
+	x, y := a.Elem[pos], b.(Scalar)
+	z := x - y
+	if a.Checked && (x^y)&(x^z) < 0 {
+		a.fail(ErrOverflow, pos)
+		return
+	}
+	a.Elem[pos] = z
+}
+
+// Multiply Scalar
+func (a *Vector) MulSc_V(pos int, b S) {
+	x, y := a.Elem[pos], b.(Scalar)
+	z := x * y
+	if a.Checked && x != 0 && (z/x != y || (x == -1 && y == math.MinInt64)) {
+		a.fail(ErrOverflow, pos)
+		return
+	}
+	a.Elem[pos] = z
+}
+
+// Divide Scalar
+func (a *Vector) DivSc_V(pos int, b S) {
+	x, y := a.Elem[pos], b.(Scalar)
+	if a.Checked {
+		if y == 0 {
+			a.fail(ErrDivideByZero, pos)
+			return
+		}
+		if x == math.MinInt64 && y == -1 {
+			a.fail(ErrOverflow, pos)
+			return
+		}
+	}
+	a.Elem[pos] = div(x, y, a.Division)
+}
+
+// Negate a vector element
+func (a *Vector) Neg_V(pos int) {
+	if a.Checked && a.Elem[pos] == math.MinInt64 {
+		a.fail(ErrOverflow, pos)
+		return
+	}
+	a.Elem[pos] = -a.Elem[pos]
+}
+
+// Vector length
+func (a *Vector) Len_V() int {
+	return len(a.Elem)
+}
+
+// Minimum relative vector length
+func (a *Vector) LenMin_V(b V) int {
+	al := len(a.Elem)
+	bl := len(b.(*Vector).Elem)
+	if al < bl {
+		return al
+	}
+	return bl
+}
+
+// Zero valued element
+func (a *Vector) Zero_V() S {
+	return Scalar(0)
+}
+
+// ... for the E API
+
+// Returns and clears the first error detected in checked mode
+func (a *Vector) Err_V() error {
+	a.Lock()
+	defer a.Unlock()
+	err := a.err
+	a.err = nil
+	return err
+}
+
+// ... for the S API
+//
+// Scalar operations are unchecked and use truncated division.
+
+// Add
+func (a Scalar) Add_S(b S) S {
+	return a + b.(Scalar)
+}
+
+// Subtract
+func (a Scalar) Sub_S(b S) S {
+	return a - b.(Scalar)
+}
+
+// Multiply
+func (a Scalar) Mul_S(b S) S {
+	return a * b.(Scalar)
+}
+
+// Divide
+func (a Scalar) Div_S(b S) S {
+	return a / b.(Scalar)
+}
+
+// Absolute value
+func (a Scalar) Abs_S() S {
+	if a < 0 {
+		return -a
+	}
+	return a
+}
+
+// Square root, rounded down; zero for negative values
+func (a Scalar) Sqrt_S() S {
+	if a <= 0 {
+		return Scalar(0)
+	}
+	// correct the float64 estimate, comparing by division to avoid overflow
+	r := Scalar(math.Sqrt(float64(a)))
+	for r > a/r {
+		r--
+	}
+	for r+1 <= a/(r+1) {
+		r++
+	}
+	return r
+}
+
+// Floor - integers are unchanged
+func (a Scalar) Floor_S() S {
+	return a
+}
+
+// Ceiling - integers are unchanged
+func (a Scalar) Ceil_S() S {
+	return a
+}
+
+// Round - integers are unchanged
+func (a Scalar) Round_S() S {
+	return a
+}
+
+// Compare
+func (a Scalar) Compare_S(b S) int {
+	return cmp.Compare(a, b.(Scalar))
+}
+
+// Zero - receiver is ignored
+func (a Scalar) Zero_S() S {
+	return Scalar(0)
+}
+
+// One - receiver is ignored
+func (a Scalar) One_S() S {
+	return Scalar(1)
+}
+
+// Convert Scalar to a float, rounding to the nearest float64
+func (a Scalar) ToFloat() float64 {
+	return float64(a)
+}
+
+// Convert a float to a Scalar, rounding half away from zero and saturating
+// at the int64 range - receiver is ignored
+func (a Scalar) ToS(v float64) S {
+	v = math.Round(v)
+	switch {
+	case v >= math.MaxInt64:
+		return Scalar(math.MaxInt64)
+	case v <= math.MinInt64:
+		return Scalar(math.MinInt64)
+	case math.IsNaN(v):
+		return Scalar(0)
+	}
+	return Scalar(v)
+}
+
+// Helper functions
+
+// fail records the first error detected in checked mode.
+func (a *Vector) fail(err error, pos int) {
+	if a.err == nil {
+		a.err = fmt.Errorf("%w: element %d", err, pos)
+	}
+}
+
+// mod computes the remainder of the element at pos divided by y, using the
+// receiver's division semantics.
+func (a *Vector) mod(pos int, y Scalar) {
+	x := a.Elem[pos]
+	if a.Checked && y == 0 {
+		a.fail(ErrDivideByZero, pos)
+		return
+	}
+	a.Elem[pos] = x - div(x, y, a.Division)*y
+}
+
+// modSc_V is the scalar form of ModOp.
+func modSc_V(res V, pos int, t S) error {
+	a, ok := res.(*Vector)
+	y, ok2 := t.(Scalar)
+	if !ok || !ok2 {
+		return fmt.Errorf("%w: mod of %T by %T", ErrUnsupportedOp, res, t)
+	}
+	a.mod(pos, y)
+	return nil
+}
+
+// div computes the quotient of x and y using the given division semantics.
+func div(x, y Scalar, mode DivMode) Scalar {
+	q, r := x/y, x%y
+	switch mode {
+	case Floored:
+		if r != 0 && (r < 0) != (y < 0) {
+			q--
+		}
+	case Euclidean:
+		if r < 0 {
+			if y > 0 {
+				q--
+			} else {
+				q++
+			}
+		}
+	}
+	return q
+}
+
+// gen_V promotes the base type to []V
+func gen_V(bi ...*Vector) []V {
+	b := make([]V, len(bi))
+	for i, v := range bi {
+		b[i] = v
+	}
+	return b
+}
@@ -0,0 +1,381 @@
+// Copyright © 2015 Gerald Rosenberg.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the License.md file.
+//
+package maths
+
+import (
+	"errors"
+	"math"
+	mbig "math/big"
+	"testing"
+
+	. "github.com/grosenberg/maths/algorithms"
+	"github.com/grosenberg/maths/big"
+	"github.com/grosenberg/maths/floats"
+)
+
+func TestMatrix32(t *testing.T) {
+	a := floats.NewMatrix32(2, 3, 1, 2, 3, 4, 5, 6)
+	b := a.Transpose32()
+	if b.Rows() != 3 || b.At(2, 1) != 6 {
+		t.Errorf("Wrong. transpose is %v", b.Elem)
+	}
+	c, err := a.MatMul32(b)
+	if err != nil || c.At(0, 0) != 14 || c.At(0, 1) != 32 || c.At(1, 1) != 77 {
+		t.Errorf("Wrong. product is %v, %v", c, err)
+	}
+	if tr, err := c.Trace32(); err != nil || tr != 91 {
+		t.Errorf("Wrong. trace is %v, %v", tr, err)
+	}
+	if _, err := a.MatMul32(a); !errors.Is(err, ErrDimensionMismatch) {
+		t.Errorf("Wrong. err is %v", err)
+	}
+	v, err := a.MulVec32(vec32(1, 0, -1))
+	if err != nil || v.Elem[0] != -2 || v.Elem[1] != -2 {
+		t.Errorf("Wrong. product is %v, %v", v, err)
+	}
+	i := floats.NewIdentity32(3)
+	if d, _ := a.MatMul32(i); d.At(1, 2) != 6 {
+		t.Errorf("Wrong. identity product is %v", d.Elem)
+	}
+}
+
+func TestMatrixBig(t *testing.T) {
+	vals := make([]mbig.Float, 4)
+	for i := range vals {
+		vals[i].SetPrec(200).SetInt64(int64(i + 1))
+	}
+	a := big.NewMatrix(2, 2, vals...)
+	b, _ := a.MatMul(big.NewIdentity(2))
+	tr, err := b.Trace()
+	if f, _ := tr.Float64(); err != nil || f != 5 {
+		t.Errorf("Wrong. trace is %v, %v", f, err)
+	}
+	if x := a.At(0, 1); x.Prec() != 200 {
+		t.Errorf("Wrong. precision is %v", x.Prec())
+	}
+}
+
+func TestSolve64(t *testing.T) {
+	a := floats.NewMatrix64(3, 3, 0, 2, 1, 1, 1, 1, 2, 1, 3)
+	x, err := a.Solve64(floats.NewVector(3))
+	if err != nil || x.Norm64(L2) != 0 {
+		t.Errorf("Wrong. x is %v, %v", x, err)
+	}
+	b := floats.NewVector(3)
+	b.Elem[0], b.Elem[1], b.Elem[2] = 7, 6, 13
+	if x, err = a.Solve64(b); err != nil || !x.ApproxEqual64(vec64(1, 2, 3), 1e-12, 0) {
+		t.Errorf("Wrong. x is %v, %v", x, err)
+	}
+	if d, err := a.Det64(); err != nil || math.Abs(d+3) > 1e-12 {
+		t.Errorf("Wrong. det is %v, %v", d, err)
+	}
+	inv, err := a.Inverse64()
+	if err != nil {
+		t.Fatalf("Wrong. err is %v", err)
+	}
+	p, _ := a.MatMul64(inv)
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			if want := floats.NewIdentity64(3).At(i, j); math.Abs(p.At(i, j)-want) > 1e-12 {
+				t.Errorf("Wrong. product is %v", p.Elem)
+			}
+		}
+	}
+
+	s := floats.NewMatrix64(2, 2, 1, 2, 2, 4+1e-14)
+	if _, err := s.Solve64(floats.NewVector(2)); !errors.Is(err, ErrSingular) {
+		t.Errorf("Wrong. err is %v", err)
+	}
+	if d, err := floats.NewMatrix64(2, 2, 1, 2, 2, 4).Det64(); err != nil || d != 0 {
+		t.Errorf("Wrong. det is %v, %v", d, err)
+	}
+}
+
+func TestSingular32(t *testing.T) {
+	a := floats.NewMatrix32(3, 3, .1, .2, .3, .4, .5, .6, .7, .8, .9)
+	if m, err := a.Inverse32(); !errors.Is(err, ErrSingular) {
+		t.Errorf("Wrong. inverse is %v, %v", m, err)
+	}
+	if _, err := floats.NewMatrix32(2, 2, 2, 1, 1, 3).Inverse32(); err != nil {
+		t.Errorf("Wrong. err is %v", err)
+	}
+}
+
+// Hilbert matrix of order n at the given precision
+func hilbert(n int, prec uint) *big.Matrix {
+	vals := make([]mbig.Float, n*n)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			d := new(mbig.Float).SetInt64(int64(i + j + 1))
+			vals[i*n+j].SetPrec(prec).Quo(mbig.NewFloat(1), d)
+		}
+	}
+	return big.NewMatrix(n, n, vals...)
+}
+
+func TestSolveBig(t *testing.T) {
+	const n = 8
+	a := hilbert(n, 256)
+	ones := big.NewVector(n)
+	for i := range ones.Elem {
+		ones.Set_V(i, big.Scalar{}.ToS(1))
+	}
+	b, _ := a.MulVec(ones)
+	x, err := a.Solve(b)
+	if err != nil {
+		t.Fatalf("Wrong. err is %v", err)
+	}
+	tol := mbig.NewFloat(1e-50)
+	for i := 0; i < n; i++ {
+		xi := x.Get_V(i).(big.Scalar)
+		d := mbig.Float(xi)
+		d.Sub(&d, mbig.NewFloat(1)).Abs(&d)
+		if d.Cmp(tol) > 0 {
+			t.Errorf("Wrong. x[%d] differs from 1 by %v", i, d.Text('e', 5))
+		}
+	}
+}
+
+// Diagonal big.Matrix of the given decimal values
+func diagBig(vals ...string) *big.Matrix {
+	n := len(vals)
+	elem := make([]mbig.Float, n*n)
+	for i := range elem {
+		elem[i].SetPrec(64)
+	}
+	for i, v := range vals {
+		elem[i*n+i].SetString(v)
+	}
+	return big.NewMatrix(n, n, elem...)
+}
+
+func TestSolveBigRange(t *testing.T) {
+	a := diagBig("1e-400", "2e-400")
+	b := big.NewVector(2)
+	b.Set_V(0, a.Get_M(0, 0))
+	b.Set_V(1, a.Get_M(1, 1))
+	if x, err := a.Solve(b); err != nil || x.Get_V(0).ToFloat() != 1 || x.Get_V(1).ToFloat() != 1 {
+		t.Errorf("Wrong. x is %v, %v", x, err)
+	}
+	d, err := a.Det()
+	want, _ := new(mbig.Float).SetPrec(64).SetString("2e-800")
+	if diff := new(mbig.Float).Sub(&d, want); err != nil || diff.Abs(diff).Cmp(new(mbig.Float).SetMantExp(want, -50)) > 0 {
+		t.Errorf("Wrong. det is %v, %v", d.Text('e', 5), err)
+	}
+	if x, err := diagBig("1e400", "2e400").Solve(b); err != nil || x.Get_V(0).ToFloat() != 0 {
+		t.Errorf("Wrong. x is %v, %v", x, err)
+	}
+	if _, err := diagBig("1e400", "1").Inverse(); !errors.Is(err, ErrSingular) {
+		t.Errorf("Wrong. err is %v", err)
+	}
+
+	// the tolerance follows the precision of the elements
+	elem := make([]mbig.Float, 4)
+	for i := range elem {
+		elem[i].SetPrec(200)
+	}
+	elem[0].SetInt64(1)
+	elem[3].SetString("1e-14")
+	if _, err := big.NewMatrix(2, 2, elem...).Inverse(); err != nil {
+		t.Errorf("Wrong. err is %v", err)
+	}
+	if _, err := diagBig("1", "1e-20").Inverse(); !errors.Is(err, ErrSingular) {
+		t.Errorf("Wrong. err is %v", err)
+	}
+}
+
+func TestQR64(t *testing.T) {
+	a := floats.NewMatrix64(4, 3, 12, -51, 4, 6, 167, -68, -4, 24, -41, 1, 2, 3)
+	q, r, err := a.QR64()
+	if err != nil {
+		t.Fatalf("Wrong. err is %v", err)
+	}
+	qr, _ := q.MatMul64(r)
+	qtq, _ := q.Transpose64().MatMul64(q)
+	for i := 0; i < 4; i++ {
+		for j := 0; j < 3; j++ {
+			if math.Abs(qr.At(i, j)-a.At(i, j)) > 1e-10 {
+				t.Errorf("Wrong. QR is %v", qr.Elem)
+			}
+			if i > j && r.At(i, j) != 0 {
+				t.Errorf("Wrong. R is %v", r.Elem)
+			}
+		}
+		for j := 0; j < 4; j++ {
+			if want := floats.NewIdentity64(4).At(i, j); math.Abs(qtq.At(i, j)-want) > 1e-12 {
+				t.Errorf("Wrong. QtQ is %v", qtq.Elem)
+			}
+		}
+	}
+}
+
+func TestCholesky64(t *testing.T) {
+	a := floats.NewMatrix64(3, 3, 4, 12, -16, 12, 37, -43, -16, -43, 98)
+	l, err := a.Cholesky64()
+	if err != nil {
+		t.Fatalf("Wrong. err is %v", err)
+	}
+	for i, want := range []float64{2, 0, 0, 6, 1, 0, -8, 5, 3} {
+		if math.Abs(float64(l.Elem[i])-want) > 1e-12 {
+			t.Errorf("Wrong. L is %v", l.Elem)
+			break
+		}
+	}
+	if _, err := floats.NewMatrix64(2, 2, 1, 2, 2, 1).Cholesky64(); !errors.Is(err, ErrNotPosDef) {
+		t.Errorf("Wrong. err is %v", err)
+	}
+}
+
+func TestLeastSquares(t *testing.T) {
+	// fit y = c0 + c1 x to (0, 1), (1, 3), (2, 4), (3, 7)
+	a := floats.NewMatrix64(4, 2, 1, 0, 1, 1, 1, 2, 1, 3)
+	b := floats.NewVector(4)
+	b.Elem[0], b.Elem[1], b.Elem[2], b.Elem[3] = 1, 3, 4, 7
+	x, r, err := a.LeastSquares64(b)
+	if err != nil || math.Abs(float64(x.Elem[0])-0.9) > 1e-12 || math.Abs(float64(x.Elem[1])-1.9) > 1e-12 {
+		t.Errorf("Wrong. x is %v, %v", x, err)
+	}
+	// residuals 0.1, 0.2, -0.7, 0.4
+	if math.Abs(r-math.Sqrt(0.7)) > 1e-12 {
+		t.Errorf("Wrong. residual is %v", r)
+	}
+
+	vals := make([]mbig.Float, 8)
+	for i, v := range []float64{1, 0, 1, 1, 1, 2, 1, 3} {
+		vals[i].SetPrec(128).SetFloat64(v)
+	}
+	bb := big.NewVector(4)
+	for i := range bb.Elem {
+		bb.Set_V(i, big.Scalar{}.ToS(float64(b.Elem[i])))
+	}
+	xb, rb, err := big.NewMatrix(4, 2, vals...).LeastSquares(bb)
+	if err != nil || math.Abs(xb.Get_V(1).ToFloat()-1.9) > 1e-15 {
+		t.Errorf("Wrong. x is %v, %v", xb, err)
+	}
+	if f, _ := rb.Float64(); math.Abs(f-math.Sqrt(0.7)) > 1e-15 {
+		t.Errorf("Wrong. residual is %v", f)
+	}
+
+	// rank deficient at float32 precision: the columns differ by 1e-6
+	c := floats.NewMatrix32(3, 2, 1, 1, 2, 2+1e-6, 3, 3)
+	if x, _, err := c.LeastSquares32(vec32(1, 2, 3)); !errors.Is(err, ErrSingular) {
+		t.Errorf("Wrong. x is %v, %v", x, err)
+	}
+	// full rank beyond the float64 range
+	db := diagBig("1e-400", "2e-400")
+	vb := big.NewVector(2)
+	vb.Set_V(0, db.Get_M(0, 0))
+	vb.Set_V(1, db.Get_M(1, 1))
+	if x, _, err := db.LeastSquares(vb); err != nil || x.Get_V(0).ToFloat() != 1 {
+		t.Errorf("Wrong. x is %v, %v", x, err)
+	}
+	// full rank at the precision of the elements
+	elem := make([]mbig.Float, 4)
+	for i := range elem {
+		elem[i].SetPrec(200)
+	}
+	elem[0].SetInt64(1)
+	elem[3].SetString("1e-14")
+	if x, _, err := big.NewMatrix(2, 2, elem...).LeastSquares(vb); err != nil {
+		t.Errorf("Wrong. x is %v, %v", x, err)
+	}
+	if x, _, err := diagBig("1", "1e-20").LeastSquares(vb); !errors.Is(err, ErrSingular) {
+		t.Errorf("Wrong. x is %v, %v", x, err)
+	}
+}
+
+func TestEigenSym(t *testing.T) {
+	a := floats.NewMatrix64(3, 3, 2, -1, 0, -1, 2, -1, 0, -1, 2)
+	vals, vecs, err := a.EigenSym64(1e-14, DefaultMaxIter)
+	if err != nil {
+		t.Fatalf("Wrong. err is %v", err)
+	}
+	if !vals.ApproxEqual64(vec64(2+math.Sqrt2, 2, 2-math.Sqrt2), 1e-12, 0) {
+		t.Errorf("Wrong. eigenvalues are %v", vals.Elem)
+	}
+	av, _ := a.MatMul64(vecs)
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			if d := av.At(i, j) - float64(vals.Elem[j])*vecs.At(i, j); math.Abs(d) > 1e-12 {
+				t.Errorf("Wrong. AV - VD is %v at %d, %d", d, i, j)
+			}
+		}
+	}
+	if _, _, err := a.EigenSym64(1e-14, 0); !errors.Is(err, ErrNoConvergence) {
+		t.Errorf("Wrong. err is %v", err)
+	}
+
+	// the float32 pipeline checked against a big.Float reference
+	vals32, _, err := floats.NewMatrix32(3, 3, 2, -1, 0, -1, 2, -1, 0, -1, 2).EigenSym32(1e-6, DefaultMaxIter)
+	if err != nil {
+		t.Fatalf("Wrong. err is %v", err)
+	}
+	elems := make([]mbig.Float, 9)
+	for i, v := range []float64{2, -1, 0, -1, 2, -1, 0, -1, 2} {
+		elems[i].SetPrec(200).SetFloat64(v)
+	}
+	valsBig, _, err := big.NewMatrix(3, 3, elems...).EigenSym(1e-40, DefaultMaxIter)
+	if err != nil {
+		t.Fatalf("Wrong. err is %v", err)
+	}
+	for i := 0; i < 3; i++ {
+		if d := float64(vals32.Elem[i]) - valsBig.Get_V(i).ToFloat(); math.Abs(d) > 1e-5 {
+			t.Errorf("Wrong. float32 eigenvalue %d differs by %v", i, d)
+		}
+	}
+}
+
+func TestSVD(t *testing.T) {
+	a := floats.NewMatrix64(2, 3, 3, 2, 2, 2, 3, -2)
+	u, s, v, err := a.SVD64(1e-15, DefaultMaxIter)
+	if err != nil {
+		t.Fatalf("Wrong. err is %v", err)
+	}
+	if math.Abs(float64(s.Elem[0])-5) > 1e-12 || math.Abs(float64(s.Elem[1])-3) > 1e-12 {
+		t.Errorf("Wrong. singular values are %v", s.Elem)
+	}
+	// A = U diag(S) V^T
+	for i := 0; i < 2; i++ {
+		for j := 0; j < 3; j++ {
+			sum := 0.0
+			for k := 0; k < 2; k++ {
+				sum += u.At(i, k) * float64(s.Elem[k]) * v.At(j, k)
+			}
+			if math.Abs(sum-a.At(i, j)) > 1e-12 {
+				t.Errorf("Wrong. reconstruction is %v at %d, %d", sum, i, j)
+			}
+		}
+	}
+}
+
+func TestEigenSVDRange(t *testing.T) {
+	// convergence tests beyond the float64 exponent range
+	elems := make([]mbig.Float, 4)
+	for i := range elems {
+		elems[i].SetPrec(64).SetString("1e-400")
+	}
+	a := big.NewMatrix(2, 2, elems...)
+	want, _ := new(mbig.Float).SetPrec(64).SetString("2e-400")
+	// error in s, expecting w, relative to want
+	rel := func(s S, w *mbig.Float) float64 {
+		d := mbig.Float(s.(big.Scalar))
+		d.Sub(&d, w)
+		f, _ := d.Quo(&d, want).Float64()
+		return math.Abs(f)
+	}
+	vals, _, err := a.EigenSym(1e-15, DefaultMaxIter)
+	if err != nil || rel(vals.Get_V(0), want) > 1e-15 || rel(vals.Get_V(1), new(mbig.Float)) > 1e-15 {
+		t.Errorf("Wrong. eigenvalues are %v, %v", vals, err)
+	}
+	_, s, _, err := a.SVD(1e-15, DefaultMaxIter)
+	if err != nil || rel(s.Get_V(0), want) > 1e-15 || rel(s.Get_V(1), new(mbig.Float)) > 1e-15 {
+		t.Errorf("Wrong. singular values are %v, %v", s, err)
+	}
+
+	_, s64, _, err := floats.NewMatrix64(2, 2, 1e160, 1e160, 1e160, 1e160).SVD64(1e-15, DefaultMaxIter)
+	if err != nil || math.Abs(float64(s64.Elem[0])/2e160-1) > 1e-15 || float64(s64.Elem[1]) > 1e145 {
+		t.Errorf("Wrong. singular values are %v, %v", s64.Elem, err)
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright © 2015 Gerald Rosenberg.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the License.md file.
+//
+package maths
+
+import (
+	"math"
+	mbig "math/big"
+	"testing"
+
+	. "github.com/grosenberg/maths/algorithms"
+	"github.com/grosenberg/maths/big"
+	"github.com/grosenberg/maths/floats"
+)
+
+func TestQuatRotation(t *testing.T) {
+	q := floats.DQuatFromAxisAngle(floats.DVec3{0, 0, 2}, math.Pi/2)
+	v := q.Rotate(floats.DVec3{1, 0, 0})
+	if v.Sub(floats.DVec3{0, 1, 0}).Length() > 1e-15 {
+		t.Errorf("Wrong. rotated is %v", v)
+	}
+	e := floats.DQuatFromEuler(0, 0, math.Pi/2)
+	if d := floats.DVec4(e).Sub(floats.DVec4(q)).Length(); d > 1e-15 {
+		t.Errorf("Wrong. euler is %v", e)
+	}
+
+	// composition: 90 degrees about X, then 90 degrees about Z
+	rx := floats.DQuatFromAxisAngle(floats.DVec3{1, 0, 0}, math.Pi/2)
+	v = q.Mul(rx).Rotate(floats.DVec3{0, 1, 0})
+	if v.Sub(floats.DVec3{0, 0, 1}).Length() > 1e-15 {
+		t.Errorf("Wrong. composed is %v", v)
+	}
+	if i := q.Mul(q.Inverse()); floats.DVec4(i).Sub(floats.DVec4(floats.DQuatIdent())).Length() > 1e-15 {
+		t.Errorf("Wrong. q q^-1 is %v", i)
+	}
+
+	// rotation matrix for 90 degrees about Z
+	m := floats.NewMatrix64(3, 3, 0, -1, 0, 1, 0, 0, 0, 0, 1)
+	if f, err := floats.DQuatFromMatrix64(m); err != nil || floats.DVec4(f).Sub(floats.DVec4(q)).Length() > 1e-15 {
+		t.Errorf("Wrong. from matrix is %v, %v", f, err)
+	}
+}
+
+func TestQuatSlerp(t *testing.T) {
+	a := floats.QuatIdent()
+	b := floats.QuatFromAxisAngle(floats.Vec3{0, 1, 0}, math.Pi/2)
+	want := floats.QuatFromAxisAngle(floats.Vec3{0, 1, 0}, math.Pi/4)
+	if d := floats.Vec4(a.Slerp(b, 0.5)).Sub(floats.Vec4(want)).Length(); d > 1e-6 {
+		t.Errorf("Wrong. slerp differs by %v", d)
+	}
+	// the negated quaternion is the same rotation
+	neg := floats.Quat(floats.Vec4(b).Negate())
+	if d := floats.Vec4(a.Slerp(neg, 0.5)).Sub(floats.Vec4(want)).Length(); d > 1e-6 {
+		t.Errorf("Wrong. shortest arc slerp differs by %v", d)
+	}
+}
+
+func TestQuatBig(t *testing.T) {
+	axis := bigVec(0, 0, 1)
+	q := big.QuatFromAxisAngle(axis, *mbig.NewFloat(math.Pi / 2))
+	v := q.Rotate(bigVec(1, 0, 0))
+	if d := v.Distance(bigVec(0, 1, 0), L2); d.Cmp(mbig.NewFloat(1e-15)) > 0 {
+		t.Errorf("Wrong. rotated is %v", v)
+	}
+	h := big.QuatIdent().Slerp(q, *mbig.NewFloat(0.5))
+	want := big.QuatFromAxisAngle(axis, *mbig.NewFloat(math.Pi / 4))
+	if d := h.Vector().Distance(want.Vector(), L2); d.Cmp(mbig.NewFloat(1e-15)) > 0 {
+		t.Errorf("Wrong. slerp is %v", h)
+	}
+}
@@ -0,0 +1,216 @@
+// Copyright © 2015 Gerald Rosenberg.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the License.md file.
+//
+package rats
+
+import (
+	"math/big"
+
+	. "github.com/grosenberg/maths/algorithms"
+	bigv "github.com/grosenberg/maths/big"
+	"github.com/grosenberg/maths/floats"
+)
+
+// Named vector element positions
+const (
+	X = iota
+	Y
+	Z
+	W
+)
+
+/////////////////////////////////////////////////////////////
+// Vector type-specific API
+
+// Create a new zero valued Vector of the given dimension
+func NewVector(dim int) *Vector {
+	v := &Vector{}
+	v.Elem = make([]*big.Rat, dim)
+	for i := range v.Elem {
+		v.Elem[i] = new(big.Rat)
+	}
+	return v
+}
+
+// Create a copy of an existing Vector
+func (a *Vector) CopyVector() *Vector {
+	b := NewVector(a.Len_V())
+	b.AddVectors(a)
+	return b
+}
+
+// Lift a float32 vector to an exact rational vector. Every finite
+// float is represented exactly; NaN and infinite elements become zero.
+func FromVector32(a *floats.Vector32) *Vector {
+	b := NewVector(a.Len_V())
+	for i := range a.Elem {
+		b.Set_V(i, b.Zero_V().ToS(a.Get_V(i).ToFloat()))
+	}
+	return b
+}
+
+// Lift a float64 vector to an exact rational vector. Every finite
+// float is represented exactly; NaN and infinite elements become zero.
+func FromVector64(a *floats.Vector64) *Vector {
+	b := NewVector(a.Len_V())
+	for i := range a.Elem {
+		b.Set_V(i, b.Zero_V().ToS(a.Get_V(i).ToFloat()))
+	}
+	return b
+}
+
+// Lift a big.Float vector to an exact rational vector. Every finite
+// value is represented exactly; infinite elements become zero.
+func FromBig(a *bigv.Vector) *Vector {
+	b := NewVector(a.Len_V())
+	for i := range a.Elem {
+		if !(*big.Float)(&a.Elem[i]).IsInf() {
+			(*big.Float)(&a.Elem[i]).Rat(b.Elem[i])
+		}
+	}
+	return b
+}
+
+// Convert to a float64 vector, rounding each element to the nearest float64
+func (a *Vector) ToVector64() *floats.Vector64 {
+	b := floats.NewVector64(a.Len_V())
+	for i := range a.Elem {
+		b.Elem[i] = floats.Scalar64(a.Get_V(i).ToFloat())
+	}
+	return b
+}
+
+// Add a set of vectors to the receiver vector
+func (a *Vector) AddVectors(bs ...*Vector) *Vector {
+	b := gen_V(bs...)
+	return Modify_V(a, AddOp, b...).(*Vector)
+}
+
+// Subtract a set of vectors from the receiver vector
+func (a *Vector) SubVectors(bs ...*Vector) *Vector {
+	b := gen_V(bs...)
+	return Modify_V(a, SubOp, b...).(*Vector)
+}
+
+// Multiply a set of vectors against the receiver vector
+func (a *Vector) MulVectors(bs ...*Vector) *Vector {
+	b := gen_V(bs...)
+	return Modify_V(a, MulOp, b...).(*Vector)
+}
+
+// Divide a set of vectors against the receiver vector
+func (a *Vector) DivVectors(bs ...*Vector) *Vector {
+	b := gen_V(bs...)
+	return Modify_V(a, DivOp, b...).(*Vector)
+}
+
+// Add a scalar value to each vector element
+func (a *Vector) AddScalar(val *big.Rat) *Vector {
+	return ModifyScalar_V(a, AddOp, (*Scalar)(val)).(*Vector)
+}
+
+// Subtract a scalar value from each vector element
+func (a *Vector) SubScalar(val *big.Rat) *Vector {
+	return ModifyScalar_V(a, SubOp, (*Scalar)(val)).(*Vector)
+}
+
+// Multiply a vector by a scalar value
+func (a *Vector) MulScalar(val *big.Rat) *Vector {
+	return ModifyScalar_V(a, MulOp, (*Scalar)(val)).(*Vector)
+}
+
+// Divide a vector by a scalar value
+func (a *Vector) DivScalar(val *big.Rat) *Vector {
+	return ModifyScalar_V(a, DivOp, (*Scalar)(val)).(*Vector)
+}
+
+// Negate a vector
+func (a *Vector) Negate() *Vector {
+	return Negate_V(a).(*Vector)
+}
+
+// Element-wise minimum of the receiver and a set of vectors
+func (a *Vector) MinVectors(bs ...*Vector) *Vector {
+	b := gen_V(bs...)
+	return Min_V(a, b...).(*Vector)
+}
+
+// Element-wise maximum of the receiver and a set of vectors
+func (a *Vector) MaxVectors(bs ...*Vector) *Vector {
+	b := gen_V(bs...)
+	return Max_V(a, b...).(*Vector)
+}
+
+// Absolute value of each vector element
+func (a *Vector) Abs() *Vector {
+	return Abs_V(a).(*Vector)
+}
+
+// Square root of each vector element - exact for perfect squares,
+// otherwise computed at float64 precision
+func (a *Vector) Sqrt() *Vector {
+	return Sqrt_V(a).(*Vector)
+}
+
+// Round each vector element down
+func (a *Vector) Floor() *Vector {
+	return Floor_V(a).(*Vector)
+}
+
+// Round each vector element up
+func (a *Vector) Ceil() *Vector {
+	return Ceil_V(a).(*Vector)
+}
+
+// Round each vector element to the nearest integer, half away from zero
+func (a *Vector) Round() *Vector {
+	return Round_V(a).(*Vector)
+}
+
+// Replace each vector element e with fn(e)
+func (a *Vector) Map(fn func(*big.Rat) *big.Rat) *Vector {
+	return Map_V(a, func(e S) S { return (*Scalar)(fn(e.(*Scalar).rat())) }).(*Vector)
+}
+
+// Replace each vector element e with fn(e, b[i]), where i is the element position
+func (a *Vector) ZipWith(b *Vector, fn func(*big.Rat, *big.Rat) *big.Rat) *Vector {
+	return ZipWith_V(a, b, func(e, f S) S { return (*Scalar)(fn(e.(*Scalar).rat(), f.(*Scalar).rat())) }).(*Vector)
+}
+
+// Dot product of two vectors
+func (a *Vector) Dot(b *Vector) *big.Rat {
+	return Dot_V(a, b).(*Scalar).rat()
+}
+
+// Sum of the vector elements
+func (a *Vector) Sum() *big.Rat {
+	return Sum_V(a).(*Scalar).rat()
+}
+
+// Product of the vector elements
+func (a *Vector) Product() *big.Rat {
+	return Product_V(a).(*Scalar).rat()
+}
+
+// Norm of order p; see L1, L2 and LInf. The L1 and LInf norms are
+// exact, as is the L2 norm where its square is a perfect rational square.
+func (a *Vector) Norm(p float64) *big.Rat {
+	return Norm_V(a, p).(*Scalar).rat()
+}
+
+// Distance between two vectors using the norm of order p
+func (a *Vector) Distance(b *Vector, p float64) *big.Rat {
+	return Distance_V(a, b, p).(*Scalar).rat()
+}
+
+// Exact equality: true where the vectors have the same dimension
+// and equal elements
+func (a *Vector) Equal(b *Vector) bool {
+	return Equal_V(a, b)
+}
+
+// Linear interpolation
+func (a *Vector) Lerp(b *Vector, t *big.Rat) *Vector {
+	return Lerp_V(a, b, (*Scalar)(t)).(*Vector)
+}
@@ -0,0 +1,233 @@
+// Copyright © 2015 Gerald Rosenberg.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the License.md file.
+//
+package rats
+
+import (
+	"math"
+	"math/big"
+	"sync"
+
+	. "github.com/grosenberg/maths/algorithms"
+)
+
+// Type specfic composite 'value' compatible with the intended generic type algorithm
+// implemenetation. Elements are never shared between vectors or scalars.
+type Vector struct {
+	sync.Mutex
+	Elem []*big.Rat
+}
+
+// Type specfic element 'value' compatible with the intended generic type algorithm
+// implemenetation. Scalars are used by pointer and are never modified once created.
+type Scalar big.Rat
+
+/////////////////////////////////////////////////////////////
+// Compile-time implementation prover
+var _ V = &Vector{}
+var _ S = &Scalar{}
+
+/////////////////////////////////////////////////////////////
+// Type-specific support for generic implementation
+
+// ... for the V API
+
+// New
+func (a *Vector) New_V() V {
+	return NewVector(a.Len_V())
+}
+
+// Dup (copy)
+func (a *Vector) Dup_V() V {
+	return a.CopyVector()
+}
+
+// Get - returns a copy of the element
+func (a *Vector) Get_V(pos int) S {
+	return (*Scalar)(new(big.Rat).Set(a.Elem[pos]))
+}
+
+// Set - stores a copy of the given element
+func (a *Vector) Set_V(pos int, b S) {
+	a.Elem[pos] = new(big.Rat).Set(b.(*Scalar).rat())
+}
+
+// Add
+func (a *Vector) Add_V(pos int, b V) {
+	a.Elem[pos].Add(a.Elem[pos], b.(*Vector).Elem[pos])
+}
+
+// Subtract
+func (a *Vector) Sub_V(pos int, b V) {
+	a.Elem[pos].Sub(a.Elem[pos], b.(*Vector).Elem[pos])
+}
+
+// Multiply
+func (a *Vector) Mul_V(pos int, b V) {
+	a.Elem[pos].Mul(a.Elem[pos], b.(*Vector).Elem[pos])
+}
+
+// Divide
+func (a *Vector) Div_V(pos int, b V) {
+	a.Elem[pos].Quo(a.Elem[pos], b.(*Vector).Elem[pos])
+}
+
+// Add Scalar
+func (a *Vector) AddSc_V(pos int, b S) {
+	a.Elem[pos].Add(a.Elem[pos], b.(*Scalar).rat())
+}
+
+// Subtract Scalar
+func (a *Vector) SubSc_V(pos int, b S) {
+	a.Elem[pos].Sub(a.Elem[pos], b.(*Scalar).rat())
+}
+
+// Multiply Scalar
+func (a *Vector) MulSc_V(pos int, b S) {
+	a.Elem[pos].Mul(a.Elem[pos], b.(*Scalar).rat())
+}
+
+// Divide Scalar
+func (a *Vector) DivSc_V(pos int, b S) {
+	a.Elem[pos].Quo(a.Elem[pos], b.(*Scalar).rat())
+}
+
+// Negate a vector element
+func (a *Vector) Neg_V(pos int) {
+	a.Elem[pos].Neg(a.Elem[pos])
+}
+
+// Vector length
+func (a *Vector) Len_V() int {
+	return len(a.Elem)
+}
+
+// Minimum relative vector length
+func (a *Vector) LenMin_V(b V) int {
+	al := len(a.Elem)
+	bl := len(b.(*Vector).Elem)
+	if al < bl {
+		return al
+	}
+	return bl
+}
+
+// Zero valued element
+func (a *Vector) Zero_V() S {
+	return (*Scalar)(new(big.Rat))
+}
+
+// ... for the S API
+
+// Add
+func (a *Scalar) Add_S(b S) S {
+	return (*Scalar)(new(big.Rat).Add(a.rat(), b.(*Scalar).rat()))
+}
+
+// Subtract
+func (a *Scalar) Sub_S(b S) S {
+	return (*Scalar)(new(big.Rat).Sub(a.rat(), b.(*Scalar).rat()))
+}
+
+// Multiply
+func (a *Scalar) Mul_S(b S) S {
+	return (*Scalar)(new(big.Rat).Mul(a.rat(), b.(*Scalar).rat()))
+}
+
+// Divide
+func (a *Scalar) Div_S(b S) S {
+	return (*Scalar)(new(big.Rat).Quo(a.rat(), b.(*Scalar).rat()))
+}
+
+// Absolute value
+func (a *Scalar) Abs_S() S {
+	return (*Scalar)(new(big.Rat).Abs(a.rat()))
+}
+
+// Square root - exact where the numerator and denominator are both perfect
+// squares, otherwise the float64 square root converted as by ToS
+func (a *Scalar) Sqrt_S() S {
+	x := a.rat()
+	if x.Sign() >= 0 {
+		n, d := new(big.Int).Sqrt(x.Num()), new(big.Int).Sqrt(x.Denom())
+		r := new(big.Rat).SetFrac(n, d)
+		if new(big.Rat).Mul(r, r).Cmp(x) == 0 {
+			return (*Scalar)(r)
+		}
+	}
+	return a.ToS(math.Sqrt(a.ToFloat()))
+}
+
+// Floor
+func (a *Scalar) Floor_S() S {
+	x := a.rat()
+	z := new(big.Int).Div(x.Num(), x.Denom())
+	return (*Scalar)(new(big.Rat).SetInt(z))
+}
+
+// Ceiling
+func (a *Scalar) Ceil_S() S {
+	x := a.rat()
+	z := new(big.Int).Div(new(big.Int).Neg(x.Num()), x.Denom())
+	return (*Scalar)(new(big.Rat).SetInt(z.Neg(z)))
+}
+
+// Round, half away from zero
+func (a *Scalar) Round_S() S {
+	x := new(big.Rat).Abs(a.rat())
+	x.Add(x, big.NewRat(1, 2))
+	z := new(big.Int).Div(x.Num(), x.Denom())
+	if a.rat().Sign() < 0 {
+		z.Neg(z)
+	}
+	return (*Scalar)(new(big.Rat).SetInt(z))
+}
+
+// Compare
+func (a *Scalar) Compare_S(b S) int {
+	return a.rat().Cmp(b.(*Scalar).rat())
+}
+
+// Zero - receiver is ignored
+func (a *Scalar) Zero_S() S {
+	return (*Scalar)(new(big.Rat))
+}
+
+// One - receiver is ignored
+func (a *Scalar) One_S() S {
+	return (*Scalar)(big.NewRat(1, 1))
+}
+
+// Convert Scalar to the nearest float64, rounding half to even
+func (a *Scalar) ToFloat() float64 {
+	f, _ := a.rat().Float64()
+	return f
+}
+
+// Convert a float to a Scalar - receiver is ignored. Every finite float64
+// is converted exactly; NaN and infinite values, which have no rational
+// representation, convert to zero.
+func (a *Scalar) ToS(v float64) S {
+	r := new(big.Rat)
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return (*Scalar)(r)
+	}
+	return (*Scalar)(r.SetFloat64(v))
+}
+
+// rat returns the scalar as a big.Rat
+func (a *Scalar) rat() *big.Rat {
+	return (*big.Rat)(a)
+}
+
+// Helper function
+
+// gen_V promotes the base type to []V
+func gen_V(bi ...*Vector) []V {
+	b := make([]V, len(bi))
+	for i, v := range bi {
+		b[i] = v
+	}
+	return b
+}
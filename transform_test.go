@@ -0,0 +1,72 @@
+// Copyright © 2015 Gerald Rosenberg.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the License.md file.
+//
+package maths
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	. "github.com/grosenberg/maths/algorithms"
+	"github.com/grosenberg/maths/floats"
+)
+
+func near3(a, b floats.Vec3) bool {
+	return a.Sub(b).Length() < 1e-5
+}
+
+func TestTransforms(t *testing.T) {
+	m := floats.Translate4(floats.Vec3{1, 2, 3}).
+		Mul(floats.Rotate4(floats.Vec3{0, 0, 1}, math.Pi/2)).
+		Mul(floats.Scale4(floats.Vec3{2, 2, 2}))
+	if p := m.TransformPoint(floats.Vec3{1, 0, 0}); !near3(p, floats.Vec3{1, 4, 3}) {
+		t.Errorf("Wrong. point is %v", p)
+	}
+	if d := m.TransformDirection(floats.Vec3{1, 0, 0}); !near3(d, floats.Vec3{0, 2, 0}) {
+		t.Errorf("Wrong. direction is %v", d)
+	}
+	inv, err := m.Inverse()
+	if err != nil {
+		t.Fatalf("Wrong. err is %v", err)
+	}
+	if p := inv.TransformPoint32(vec32(1, 4, 3)); !near3(floats.Vec3FromVector32(p), floats.Vec3{1, 0, 0}) {
+		t.Errorf("Wrong. inverse point is %v", p.Elem)
+	}
+	if m.At(0, 3) != 1 || m.Transpose().At(3, 0) != 1 {
+		t.Errorf("Wrong. translation column is %v", m)
+	}
+}
+
+func TestSingularMat3(t *testing.T) {
+	m := floats.Mat3FromRows(.1, .2, .3, .4, .5, .6, .7, .8, .9)
+	if inv, err := m.Inverse(); !errors.Is(err, ErrSingular) {
+		t.Errorf("Wrong. inverse is %v, %v", inv, err)
+	}
+	if _, err := floats.Scale3(floats.Vec3{1, 1, 0}).Inverse(); !errors.Is(err, ErrSingular) {
+		t.Errorf("Wrong. err is %v", err)
+	}
+	if _, err := floats.Scale4(floats.Vec3{1e-3, 1, 1}).Inverse(); err != nil {
+		t.Errorf("Wrong. err is %v", err)
+	}
+}
+
+func TestProjections(t *testing.T) {
+	v := floats.LookAt(floats.Vec3{0, 0, 5}, floats.Vec3{}, floats.Vec3{0, 1, 0})
+	if p := v.TransformPoint(floats.Vec3{0, 0, 0}); !near3(p, floats.Vec3{0, 0, -5}) {
+		t.Errorf("Wrong. view point is %v", p)
+	}
+	p := floats.Perspective(math.Pi/2, 1, 1, 10)
+	// near and far plane points map to depths -1 and 1
+	if n := p.TransformPoint(floats.Vec3{1, 1, -1}); !near3(n, floats.Vec3{1, 1, -1}) {
+		t.Errorf("Wrong. near point is %v", n)
+	}
+	if f := p.TransformPoint(floats.Vec3{0, 0, -10}); !near3(f, floats.Vec3{0, 0, 1}) {
+		t.Errorf("Wrong. far point is %v", f)
+	}
+	o := floats.Orthographic(-2, 2, -1, 1, 0, 4)
+	if c := o.TransformPoint(floats.Vec3{2, -1, -4}); !near3(c, floats.Vec3{1, -1, 1}) {
+		t.Errorf("Wrong. ortho point is %v", c)
+	}
+}
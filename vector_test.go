@@ -0,0 +1,615 @@
+// Copyright © 2015 Gerald Rosenberg.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the License.md file.
+//
+package maths
+
+import (
+	"errors"
+	"math"
+	mbig "math/big"
+	"testing"
+
+	. "github.com/grosenberg/maths/algorithms"
+	"github.com/grosenberg/maths/big"
+	"github.com/grosenberg/maths/complexes"
+	"github.com/grosenberg/maths/floats"
+	"github.com/grosenberg/maths/ints"
+	"github.com/grosenberg/maths/rats"
+)
+
+func vec32(vals ...float32) *floats.Vector32 {
+	v := floats.NewVector32(len(vals))
+	for i, x := range vals {
+		v.Elem[i] = floats.Scalar32(x)
+	}
+	return v
+}
+
+func vec64(vals ...float64) *floats.Vector64 {
+	v := floats.NewVector64(len(vals))
+	for i, x := range vals {
+		v.Elem[i] = floats.Scalar64(x)
+	}
+	return v
+}
+
+func TestModifyErrStrict(t *testing.T) {
+	a, b := vec32(1, 2, 3), vec32(1, 2)
+	if _, err := ModifyErr_V(Strict, a, AddOp, b); !errors.Is(err, ErrDimensionMismatch) {
+		t.Errorf("Wrong. err is %v", err)
+	}
+	if a.Elem[0] != 1 {
+		t.Errorf("Wrong. a modified to %v", a.Elem)
+	}
+	if _, err := ModifyErr_V(Lenient, a, AddOp, b); err != nil {
+		t.Errorf("Wrong. err is %v", err)
+	}
+	if a.Elem[0] != 2 || a.Elem[1] != 4 || a.Elem[2] != 3 {
+		t.Errorf("Wrong. a is %v", a.Elem)
+	}
+}
+
+func TestModifyErrChecks(t *testing.T) {
+	a := vec32(1, 2)
+	if _, err := ModifyErr_V(Strict, a, DivOp, vec32(1, 0)); !errors.Is(err, ErrDivideByZero) {
+		t.Errorf("Wrong. err is %v", err)
+	}
+	if _, err := ModifyErr_V(Strict, a, AddOp, big.NewVector(2)); !errors.Is(err, ErrTypeMismatch) {
+		t.Errorf("Wrong. err is %v", err)
+	}
+	if _, err := ModifyErr_V(Strict, a, 42, vec32(1, 1)); !errors.Is(err, ErrUnknownOp) {
+		t.Errorf("Wrong. err is %v", err)
+	}
+	if _, err := ModifyScalarErr_V(a, DivOp, floats.Scalar32(0)); !errors.Is(err, ErrDivideByZero) {
+		t.Errorf("Wrong. err is %v", err)
+	}
+	if _, err := ModifyScalarErr_V(a, MulOp, big.Scalar{}); !errors.Is(err, ErrTypeMismatch) {
+		t.Errorf("Wrong. err is %v", err)
+	}
+}
+
+// Half of each element, registered once in vector form only
+var halfOp = RegisterOp("test-half", func(res V, pos int, src V) error {
+	res.Set_V(pos, res.Get_V(pos).Div_S(res.Get_V(pos).ToS(2)))
+	return nil
+}, nil)
+
+func TestOpRegistry(t *testing.T) {
+	a := vec32(3, 5)
+	a.HypotVectors32(vec32(4, 12))
+	if a.Elem[0] != 5 || a.Elem[1] != 13 {
+		t.Errorf("Wrong. hypot is %v", a.Elem)
+	}
+	if s := floats.HypotOp.String(); s != "hypot" {
+		t.Errorf("Wrong. name is %v", s)
+	}
+
+	if Modify_V(a, halfOp, a); a.Elem[0] != 2.5 {
+		t.Errorf("Wrong. a is %v", a.Elem)
+	}
+	if _, err := ModifyScalarErr_V(a, halfOp, floats.Scalar32(1)); !errors.Is(err, ErrUnsupportedOp) {
+		t.Errorf("Wrong. err is %v", err)
+	}
+	if _, err := ModifyErr_V(Strict, a, ints.ModOp, vec32(1, 1)); !errors.Is(err, ErrUnsupportedOp) {
+		t.Errorf("Wrong. err is %v", err)
+	}
+	if a.Elem[0] != 2.5 || a.Elem[1] != 6.5 {
+		t.Errorf("Wrong. a modified to %v", a.Elem)
+	}
+	if ModifyScalar_V(a, 42, floats.Scalar32(1)); a.Elem[0] != 2.5 || a.Elem[1] != 6.5 {
+		t.Errorf("Wrong. unknown op modified a to %v", a.Elem)
+	}
+	defer func() {
+		if err, _ := recover().(error); !errors.Is(err, ErrUnsupportedOp) {
+			t.Errorf("Wrong. panic is %v", err)
+		}
+	}()
+	Modify_V(a, ints.ModOp, vec32(1, 1))
+}
+
+func bigVec(vals ...float64) *big.Vector {
+	v := big.NewVector(len(vals))
+	for i, x := range vals {
+		v.Set_V(i, big.Scalar{}.ToS(x))
+	}
+	return v
+}
+
+func TestReductions32(t *testing.T) {
+	a, b := vec32(1, 2, -2), vec32(3, 0, 4)
+	if d := a.Dot32(b); d != -5 {
+		t.Errorf("Wrong. dot is %v", d)
+	}
+	if s := a.Sum32(); s != 1 {
+		t.Errorf("Wrong. sum is %v", s)
+	}
+	if p := a.Product32(); p != -4 {
+		t.Errorf("Wrong. product is %v", p)
+	}
+	for _, c := range []struct{ p, want float64 }{{L1, 5}, {L2, 3}, {LInf, 2}, {3, 2.571282}} {
+		if n := a.Norm32(c.p); math.Abs(float64(n)-c.want) > 1e-5 {
+			t.Errorf("Wrong. norm %v is %v", c.p, n)
+		}
+	}
+	if d := a.Distance32(b, L2); math.Abs(float64(d)-math.Sqrt(4+4+36)) > 1e-5 {
+		t.Errorf("Wrong. distance is %v", d)
+	}
+	if c := a.CosineSimilarity32(b); math.Abs(float64(c)+1.0/3) > 1e-6 {
+		t.Errorf("Wrong. cosine is %v", c)
+	}
+}
+
+func TestReductionsBig(t *testing.T) {
+	a, b := bigVec(1, 2, -2), bigVec(3, 0, 4)
+	d := a.Dot(b)
+	if f, _ := d.Float64(); f != -5 {
+		t.Errorf("Wrong. dot is %v", f)
+	}
+	n := a.Norm(L2)
+	if f, _ := n.Float64(); f != 3 {
+		t.Errorf("Wrong. norm is %v", f)
+	}
+	if f := a.Get_V(0).ToFloat(); f != 1 {
+		t.Errorf("Wrong. a modified to %v", f)
+	}
+}
+
+func TestScalarOps32(t *testing.T) {
+	a := vec32(1, 2, 3)
+	a.MulScalar32(2).AddScalar32(1)
+	if a.Elem[0] != 3 || a.Elem[1] != 5 || a.Elem[2] != 7 {
+		t.Errorf("Wrong. a is %v", a.Elem)
+	}
+	a.SubScalar32(1).DivScalar32(2).PowScalar32(2)
+	if a.Elem[0] != 1 || a.Elem[1] != 4 || a.Elem[2] != 9 {
+		t.Errorf("Wrong. a is %v", a.Elem)
+	}
+	a.ClampScalar32(2, 5)
+	if a.Elem[0] != 2 || a.Elem[1] != 4 || a.Elem[2] != 5 {
+		t.Errorf("Wrong. a is %v", a.Elem)
+	}
+}
+
+func TestElementWise32(t *testing.T) {
+	eq := func(v *floats.Vector32, want ...float32) bool {
+		return Equal_V(v, vec32(want...))
+	}
+	a := vec32(-1.5, 2.5, 0.25)
+	if a.MaxVectors32(vec32(0, 3, 0)).MinVectors32(vec32(1, 2, 1)); !eq(a, 0, 2, 0.25) {
+		t.Errorf("Wrong. min/max is %v", a.Elem)
+	}
+	if r := vec32(-1.5, 2.5, -0.4).Abs32(); !eq(r, 1.5, 2.5, 0.4) {
+		t.Errorf("Wrong. abs is %v", r.Elem)
+	}
+	if r := vec32(-1.5, 2.5, -0.4).Floor32(); !eq(r, -2, 2, -1) {
+		t.Errorf("Wrong. floor is %v", r.Elem)
+	}
+	if r := vec32(-1.5, 2.5, -0.4).Ceil32(); !eq(r, -1, 3, 0) {
+		t.Errorf("Wrong. ceil is %v", r.Elem)
+	}
+	if r := vec32(-1.5, 2.5, -0.4).Round32(); !eq(r, -2, 3, 0) {
+		t.Errorf("Wrong. round is %v", r.Elem)
+	}
+	if r := vec32(4, 9, 0.25).Sqrt32(); !eq(r, 2, 3, 0.5) {
+		t.Errorf("Wrong. sqrt is %v", r.Elem)
+	}
+	if r := vec32(1, 2, 3).Map32(func(x float32) float32 { return x * x }); !eq(r, 1, 4, 9) {
+		t.Errorf("Wrong. map is %v", r.Elem)
+	}
+	hypot := func(x, y float32) float32 { return float32(math.Hypot(float64(x), float64(y))) }
+	if r := vec32(3, 5, 8).ZipWith32(vec32(4, 12), hypot); !eq(r, 5, 13, 8) {
+		t.Errorf("Wrong. zip is %v", r.Elem)
+	}
+}
+
+func TestRoundingExact(t *testing.T) {
+	// 2^60 + 1/2 is not representable as a float64
+	x := new(mbig.Rat).SetFrac(new(mbig.Int).Lsh(mbig.NewInt(1), 61), mbig.NewInt(2))
+	x.Add(x, mbig.NewRat(1, 2))
+	r := rats.NewVector(3)
+	r.Elem[0].Set(x)
+	r.Elem[1].SetFrac64(-5, 2)
+	r.Elem[2].SetFrac64(-7, 3)
+	r.Round()
+	want := new(mbig.Int).Add(new(mbig.Int).Lsh(mbig.NewInt(1), 60), mbig.NewInt(1))
+	if !r.Elem[0].IsInt() || r.Elem[0].Num().Cmp(want) != 0 {
+		t.Errorf("Wrong. round is %v", r.Elem[0])
+	}
+	if r.Elem[1].Cmp(mbig.NewRat(-3, 1)) != 0 || r.Elem[2].Cmp(mbig.NewRat(-2, 1)) != 0 {
+		t.Errorf("Wrong. round is %v", r.Elem)
+	}
+
+	b := big.NewVector(2)
+	f := new(mbig.Float).SetPrec(128).SetRat(x)
+	b.Set_V(0, big.Scalar(*f))
+	b.Set_V(1, big.Scalar{}.ToS(-2.25))
+	b.Floor()
+	g := mbig.Float(b.Get_V(0).(big.Scalar))
+	if i, _ := g.Int(nil); i.Cmp(new(mbig.Int).Lsh(mbig.NewInt(1), 60)) != 0 {
+		t.Errorf("Wrong. floor is %v", i)
+	}
+	if f := b.Get_V(1).ToFloat(); f != -3 {
+		t.Errorf("Wrong. floor is %v", f)
+	}
+}
+
+func TestApproxEqual(t *testing.T) {
+	if d := floats.ULPDistance32(1, math.Nextafter32(1, 2)); d != 1 {
+		t.Errorf("Wrong. ulp distance is %v", d)
+	}
+	if d := floats.ULPDistance64(-math.SmallestNonzeroFloat64, math.SmallestNonzeroFloat64); d != 2 {
+		t.Errorf("Wrong. ulp distance across zero is %v", d)
+	}
+	if d := floats.ULPDistance64(0, math.Copysign(0, -1)); d != 0 {
+		t.Errorf("Wrong. ulp distance of zeros is %v", d)
+	}
+	a := vec64(1e6, 1e-9, 0)
+	if !a.ApproxEqual64(vec64(1e6+0.5, 2e-9, 0), 1e-8, 1e-6) {
+		t.Errorf("Wrong. %v not approximately equal", a.Elem)
+	}
+	if a.ApproxEqual64(vec64(1e6+2, 0, 0), 1e-8, 1e-6) || a.ApproxEqual64(vec64(1e6, 0), 1, 1) {
+		t.Errorf("Wrong. %v approximately equal", a.Elem)
+	}
+	if n := vec64(math.NaN()); n.ApproxEqual64(n, 1, 1) {
+		t.Errorf("Wrong. NaN approximately equal")
+	}
+	inf := vec64(math.Inf(1))
+	if inf.ApproxEqual64(vec64(1), 0, 1e-9) || inf.ApproxEqual64(vec64(math.Inf(-1)), 0, 1e-9) || !inf.ApproxEqual64(inf, 0, 1e-9) {
+		t.Errorf("Wrong. infinity approximately equal to %v", inf.Elem)
+	}
+	huge, _ := new(mbig.Float).SetString("1e400")
+	hb := big.NewVector(1)
+	hb.Set_V(0, big.Scalar(*huge))
+	hb2 := big.NewVector(1)
+	hb2.Set_V(0, big.Scalar(*new(mbig.Float).Add(huge, new(mbig.Float).SetMantExp(huge, -50))))
+	if !hb.ApproxEqual(hb2, 0, 1e-9) {
+		t.Errorf("Wrong. big values beyond the float64 range not approximately equal")
+	}
+	if !intVec(ints.Truncated, 1, 2).Equal(intVec(ints.Floored, 1, 2)) {
+		t.Errorf("Wrong. int vectors not equal")
+	}
+}
+
+func TestCompareBig(t *testing.T) {
+	// values that differ only beyond float64 precision
+	one := mbig.NewFloat(1).SetPrec(200)
+	tiny := new(mbig.Float).SetMantExp(one, -100)
+	a := big.NewVector(2)
+	a.Set_V(0, big.Scalar(*one))
+	a.Set_V(1, big.Scalar(*new(mbig.Float).Add(one, tiny)))
+	b := a.CopyVector()
+	b.Set_V(0, a.Get_V(1))
+	b.Set_V(1, a.Get_V(0))
+
+	m := a.CopyVector().MaxVectors(b)
+	if m.Get_V(0).Compare_S(a.Get_V(1)) != 0 || m.Get_V(1).Compare_S(a.Get_V(1)) != 0 {
+		t.Errorf("Wrong. max is %v", m.Elem)
+	}
+	if n := a.Norm(LInf); n.Cmp(new(mbig.Float).Add(one, tiny)) != 0 {
+		t.Errorf("Wrong. norm is %v", n.Text('g', 40))
+	}
+	if Equal_V(a, b) || !a.ApproxEqual(b, 1e-15, 0) {
+		t.Errorf("Wrong. equality of %v and %v", a.Elem, b.Elem)
+	}
+}
+
+func TestSLerpRotation32(t *testing.T) {
+	a, b := vec32(2, 0, 0), vec32(0, 3, 0)
+	h := float32(math.Sqrt2 / 2)
+	if r := a.SLerp32(b, 0.5); !r.ApproxEqual32(vec32(h, h, 0), 1e-6, 0) {
+		t.Errorf("Wrong. r is %v", r.Elem)
+	}
+	if a.Elem[0] != 2 || b.Elem[1] != 3 {
+		t.Errorf("Wrong. inputs modified to %v and %v", a.Elem, b.Elem)
+	}
+	want := vec32(float32(math.Cos(math.Pi/6)), float32(math.Sin(math.Pi/6)), 0)
+	if r := a.SLerp32(b, 1.0/3); !r.ApproxEqual32(want, 1e-6, 0) {
+		t.Errorf("Wrong. r is %v", r.Elem)
+	}
+}
+
+func TestSLerpQuaternion32(t *testing.T) {
+	// identity and a 90 degree rotation about Z, as (x, y, z, w)
+	s, c := float32(math.Sin(math.Pi/4)), float32(math.Cos(math.Pi/4))
+	q0, q1 := vec32(0, 0, 0, 1), vec32(0, 0, s, c)
+	want := vec32(0, 0, float32(math.Sin(math.Pi/8)), float32(math.Cos(math.Pi/8)))
+
+	if r := q0.SLerpShortest32(q1, 0.5); !r.ApproxEqual32(want, 1e-6, 0) {
+		t.Errorf("Wrong. r is %v", r.Elem)
+	}
+
+	// -q1 is the same rotation; the shortest arc yields the same result
+	if r := q0.SLerpShortest32(vec32(0, 0, -s, -c), 0.5); !r.ApproxEqual32(want, 1e-6, 0) {
+		t.Errorf("Wrong. r is %v", r.Elem)
+	}
+	if q1.Elem[floats.Z] != floats.Scalar32(s) {
+		t.Errorf("Wrong. q1 modified to %v", q1.Elem)
+	}
+}
+
+func TestNLerpBig(t *testing.T) {
+	a, b := bigVec(1, 0), bigVec(0, 1)
+	r := a.NLerp(b, *mbig.NewFloat(0.5))
+	n := r.Norm(L2)
+	if f, _ := n.Float64(); math.Abs(f-1) > 1e-15 {
+		t.Errorf("Wrong. norm is %v", f)
+	}
+	r = a.SLerp(b, *mbig.NewFloat(0.5))
+	if f := r.Get_V(0).ToFloat(); math.Abs(f-math.Sqrt2/2) > 1e-15 {
+		t.Errorf("Wrong. r[0] is %v", f)
+	}
+}
+
+func TestGeometry32(t *testing.T) {
+	eq := func(v *floats.Vector32, want ...float32) bool {
+		return v.ApproxEqual32(vec32(want...), 1e-6, 0)
+	}
+	a, b := vec32(3, 4, 0), vec32(2, 0, 0)
+	if r := a.Cross32(b); !eq(r, 0, 0, -8) {
+		t.Errorf("Wrong. cross is %v", r.Elem)
+	}
+	if r := a.Project32(b); !eq(r, 3, 0, 0) {
+		t.Errorf("Wrong. projection is %v", r.Elem)
+	}
+	if r := a.Reject32(b); !eq(r, 0, 4, 0) {
+		t.Errorf("Wrong. rejection is %v", r.Elem)
+	}
+	if r := a.Project32(vec32(0, 0, 0)); !eq(r, 0, 0, 0) {
+		t.Errorf("Wrong. projection onto zero is %v", r.Elem)
+	}
+	if r := vec32(1, -1, 0).Reflect32(vec32(0, 1, 0)); !eq(r, 1, 1, 0) {
+		t.Errorf("Wrong. reflection is %v", r.Elem)
+	}
+	if g := a.Angle32(b); math.Abs(float64(g)-math.Acos(0.6)) > 1e-6 {
+		t.Errorf("Wrong. angle is %v", g)
+	}
+	if r := a.Dup_V().(*floats.Vector32).Normalize32(); !eq(r, 0.6, 0.8, 0) {
+		t.Errorf("Wrong. normalized is %v", r.Elem)
+	}
+	if !eq(a, 3, 4, 0) || !eq(b, 2, 0, 0) {
+		t.Errorf("Wrong. inputs modified to %v and %v", a.Elem, b.Elem)
+	}
+
+	// 45 degree incidence; unit eta passes straight through,
+	// and a large eta is totally internally reflected
+	h := float32(math.Sqrt2 / 2)
+	v, n := vec32(h, -h, 0), vec32(0, 1, 0)
+	if r := v.Refract32(n, 1); !eq(r, h, -h, 0) {
+		t.Errorf("Wrong. refraction is %v", r.Elem)
+	}
+	if r := v.Refract32(n, 1.5); !eq(r, 0, 0, 0) {
+		t.Errorf("Wrong. total internal reflection is %v", r.Elem)
+	}
+	if r := v.Refract32(n, 0.5); !eq(r, h/2, -float32(math.Sqrt(1-0.125)), 0) {
+		t.Errorf("Wrong. refraction is %v", r.Elem)
+	}
+}
+
+func TestGeometryBig(t *testing.T) {
+	a, b := bigVec(3, 4, 0), bigVec(2, 0, 0)
+	if r := a.Reject(b); !Equal_V(r, bigVec(0, 4, 0)) {
+		t.Errorf("Wrong. rejection is %v", r.Elem)
+	}
+	g := a.Angle(b)
+	if f, _ := g.Float64(); math.Abs(f-math.Acos(0.6)) > 1e-15 {
+		t.Errorf("Wrong. angle is %v", f)
+	}
+}
+
+func TestVector64(t *testing.T) {
+	a := floats.NewVector(3)
+	a.Elem[0], a.Elem[1], a.Elem[2] = 0.1, 0.2, 0.3
+	b := a.CopyVector64().MulScalar64(10)
+	if d := a.Dot64(b); math.Abs(d-1.4) > 1e-15 {
+		t.Errorf("Wrong. dot is %v", d)
+	}
+	if n := b.Norm64(LInf); n != 3 {
+		t.Errorf("Wrong. norm is %v", n)
+	}
+}
+
+func intVec(mode ints.DivMode, vals ...int64) *ints.Vector {
+	v := ints.NewVector(len(vals))
+	v.Division = mode
+	for i, x := range vals {
+		v.Elem[i] = ints.Scalar(x)
+	}
+	return v
+}
+
+func TestIntDivision(t *testing.T) {
+	for _, c := range []struct {
+		mode ints.DivMode
+		want []ints.Scalar
+	}{
+		{ints.Truncated, []ints.Scalar{-2, 2, -2, 2}},
+		{ints.Floored, []ints.Scalar{-3, 2, -3, 2}},
+		{ints.Euclidean, []ints.Scalar{-3, 3, -2, 2}},
+	} {
+		a := intVec(c.mode, -7, -7, 7, 7)
+		a.DivVectors(intVec(c.mode, 3, -3, -3, 3))
+		for i, want := range c.want {
+			if a.Elem[i] != want {
+				t.Errorf("Wrong. mode %v gives %v", c.mode, a.Elem)
+				break
+			}
+		}
+	}
+}
+
+func TestIntMod(t *testing.T) {
+	for _, c := range []struct {
+		mode ints.DivMode
+		want []ints.Scalar
+	}{
+		{ints.Truncated, []ints.Scalar{-1, -1, 1, 1}},
+		{ints.Floored, []ints.Scalar{2, -1, -2, 1}},
+		{ints.Euclidean, []ints.Scalar{2, 2, 1, 1}},
+	} {
+		a := intVec(c.mode, -7, -7, 7, 7)
+		a.ModVectors(intVec(c.mode, 3, -3, -3, 3))
+		for i, want := range c.want {
+			if a.Elem[i] != want {
+				t.Errorf("Wrong. mode %v gives %v", c.mode, a.Elem)
+				break
+			}
+		}
+	}
+	a := intVec(ints.Truncated, 7, 8)
+	a.Checked = true
+	if _, err := ModifyScalarErr_V(a, ints.ModOp, ints.Scalar(0)); !errors.Is(err, ErrDivideByZero) {
+		t.Errorf("Wrong. err is %v", err)
+	}
+	// the zero divisor is reported before computing, unchecked or not
+	a.Checked = false
+	if _, err := ModifyScalarErr_V(a, ints.ModOp, ints.Scalar(0)); !errors.Is(err, ErrDivideByZero) {
+		t.Errorf("Wrong. err is %v", err)
+	}
+	if _, err := ModifyErr_V(Strict, a, ints.ModOp, intVec(ints.Truncated, 3, 0)); !errors.Is(err, ErrDivideByZero) {
+		t.Errorf("Wrong. err is %v", err)
+	}
+	if a.Elem[0] != 7 || a.Elem[1] != 8 {
+		t.Errorf("Wrong. a modified to %v", a.Elem)
+	}
+}
+
+func TestIntOverflow(t *testing.T) {
+	a := intVec(ints.Truncated, math.MaxInt64, 1)
+	a.Checked = true
+	if _, err := ModifyScalarErr_V(a, AddOp, ints.Scalar(1)); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Wrong. err is %v", err)
+	}
+	if a.Elem[0] != math.MaxInt64 || a.Elem[1] != 2 {
+		t.Errorf("Wrong. a is %v", a.Elem)
+	}
+	a.DivScalar(0)
+	if err := a.Err(); !errors.Is(err, ErrDivideByZero) {
+		t.Errorf("Wrong. err is %v", err)
+	}
+	if err := a.Err(); err != nil {
+		t.Errorf("Wrong. err is %v", err)
+	}
+	// errors read while another goroutine records them
+	done := make(chan bool)
+	go func() {
+		for i := 0; i < 100; i++ {
+			a.DivScalar(0)
+		}
+		done <- true
+	}()
+	for i := 0; i < 100; i++ {
+		a.Err()
+	}
+	<-done
+	for _, c := range []struct{ a, want ints.Scalar }{
+		{math.MaxInt64, 3037000499}, {3037000499 * 3037000499, 3037000499},
+		{3037000499*3037000499 - 1, 3037000498}, {15, 3}, {16, 4}, {1, 1},
+	} {
+		if r := c.a.Sqrt_S(); r != c.want {
+			t.Errorf("Wrong. sqrt of %d is %v, want %d", c.a, r, c.want)
+		}
+	}
+}
+
+func TestRatsExact(t *testing.T) {
+	a := rats.FromVector32(vec32(0.1, 0.5))
+	if a.Elem[0].Cmp(mbig.NewRat(1, 10)) == 0 {
+		t.Errorf("Wrong. float32 0.1 lifted as exactly 1/10")
+	}
+	b := rats.NewVector(3)
+	c := rats.NewVector(3)
+	for i := range b.Elem {
+		b.Elem[i].SetFrac64(1, 3)
+		c.Elem[i].SetFrac64(int64(i+1), 7)
+	}
+	// 1/3 * (1 + 2 + 3) / 7
+	if d := b.Dot(c); d.Cmp(mbig.NewRat(2, 7)) != 0 {
+		t.Errorf("Wrong. dot is %v", d)
+	}
+	r := b.Lerp(c, mbig.NewRat(1, 3))
+	// 1/3 + (3/7 - 1/3) / 3
+	if r.Elem[2].Cmp(mbig.NewRat(23, 63)) != 0 {
+		t.Errorf("Wrong. lerp is %v", r.Elem[2])
+	}
+	if b.Elem[0].Cmp(mbig.NewRat(1, 3)) != 0 {
+		t.Errorf("Wrong. b modified to %v", b.Elem[0])
+	}
+	n := rats.NewVector(2)
+	n.Elem[0].SetFrac64(3, 5)
+	n.Elem[1].SetFrac64(4, 5)
+	if l := n.Norm(L2); l.Cmp(mbig.NewRat(1, 1)) != 0 {
+		t.Errorf("Wrong. norm is %v", l)
+	}
+}
+
+func TestComplexVector(t *testing.T) {
+	a := complexes.NewVector128(2)
+	b := complexes.NewVector128(2)
+	a.Elem[0], a.Elem[1] = 1+2i, 3i
+	b.Elem[0], b.Elem[1] = 2-1i, 1+1i
+	// conj(1+2i)(2-i) + conj(3i)(1+i) = (-5i) + (3-3i)
+	if d := a.HermitianDot128(b); d != 3-8i {
+		t.Errorf("Wrong. hermitian dot is %v", d)
+	}
+	if d := a.HermitianDot128(a); d != 14 {
+		t.Errorf("Wrong. hermitian square is %v", d)
+	}
+	if n := a.Norm128(L2); math.Abs(n-math.Sqrt(14)) > 1e-15 {
+		t.Errorf("Wrong. norm is %v", n)
+	}
+	r, theta := a.Polar()
+	c := complexes.FromPolar(r, theta)
+	if d := c.Distance128(a, L2); d > 1e-15 {
+		t.Errorf("Wrong. polar round trip is %v", c.Elem)
+	}
+	if theta.Elem[1] != math.Pi/2 {
+		t.Errorf("Wrong. arg is %v", theta.Elem[1])
+	}
+	if _, err := ModifyScalarErr_V(a, DivOp, complexes.Scalar128(2i)); err != nil {
+		t.Errorf("Wrong. err is %v", err)
+	}
+
+	// the float64 operations support only real values
+	if _, err := ModifyScalarErr_V(a, PowOp, complexes.Scalar128(2)); !errors.Is(err, ErrUnsupportedOp) {
+		t.Errorf("Wrong. err is %v", err)
+	}
+	re := complexes.NewVector128(2)
+	re.Elem[0], re.Elem[1] = 3, 5
+	if _, err := ModifyErr_V(Strict, re, floats.HypotOp, b); !errors.Is(err, ErrUnsupportedOp) {
+		t.Errorf("Wrong. err is %v", err)
+	}
+	if _, err := ModifyScalarErr_V(re, PowOp, complexes.Scalar128(2)); err != nil || re.Elem[1] != 25 {
+		t.Errorf("Wrong. re is %v, %v", re.Elem, err)
+	}
+}
+
+func TestFixedVectors(t *testing.T) {
+	x, y := floats.Vec3{1, 0, 0}, floats.Vec3{0, 1, 0}
+	if c := x.Cross(y); c != (floats.Vec3{0, 0, 1}) {
+		t.Errorf("Wrong. cross is %v", c)
+	}
+	v := floats.Vec3{1, 2, 3}
+	if s := v.ZYX(); s != (floats.Vec3{3, 2, 1}) {
+		t.Errorf("Wrong. ZYX is %v", s)
+	}
+	if s := v.XYZ1(); s != (floats.Vec4{1, 2, 3, 1}) || s.W() != 1 {
+		t.Errorf("Wrong. XYZ1 is %v", s)
+	}
+	if s := v.XY(); s != (floats.Vec2{1, 2}) {
+		t.Errorf("Wrong. XY is %v", s)
+	}
+	if l := v.Lerp(floats.Vec3{3, 2, 1}, 0.5); l != (floats.Vec3{2, 2, 2}) {
+		t.Errorf("Wrong. lerp is %v", l)
+	}
+	if v != (floats.Vec3{1, 2, 3}) {
+		t.Errorf("Wrong. v modified to %v", v)
+	}
+	s := floats.DVec2{2, 0}.SLerp(floats.DVec2{0, 2}, 0.5)
+	if math.Abs(s.X()-math.Sqrt2/2) > 1e-15 || math.Abs(s.Y()-math.Sqrt2/2) > 1e-15 {
+		t.Errorf("Wrong. slerp is %v", s)
+	}
+	if w := floats.Vec3FromVector32(v.Vector32().AddVectors32(vec32(1, 1, 1))); w != (floats.Vec3{2, 3, 4}) {
+		t.Errorf("Wrong. round trip is %v", w)
+	}
+}